@@ -0,0 +1,1450 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: musiclib/v1/musiclib.proto
+
+package musiclibv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Song mirrors models.Song.
+type Song struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Group string                 `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	Title string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// YYYY-MM-DD, YYYY-MM or YYYY depending on how precisely the date is known; empty if unknown.
+	ReleaseDate   string   `protobuf:"bytes,4,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
+	Link          string   `protobuf:"bytes,5,opt,name=link,proto3" json:"link,omitempty"`
+	Lyrics        []*Lyric `protobuf:"bytes,6,rep,name=lyrics,proto3" json:"lyrics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Song) Reset() {
+	*x = Song{}
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Song) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Song) ProtoMessage() {}
+
+func (x *Song) ProtoReflect() protoreflect.Message {
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Song.ProtoReflect.Descriptor instead.
+func (*Song) Descriptor() ([]byte, []int) {
+	return file_musiclib_v1_musiclib_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Song) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Song) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *Song) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Song) GetReleaseDate() string {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return ""
+}
+
+func (x *Song) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *Song) GetLyrics() []*Lyric {
+	if x != nil {
+		return x.Lyrics
+	}
+	return nil
+}
+
+// Lyric mirrors models.Lyric.
+type Lyric struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SongId        uint64                 `protobuf:"varint,2,opt,name=song_id,json=songId,proto3" json:"song_id,omitempty"`
+	VerseNumber   int32                  `protobuf:"varint,3,opt,name=verse_number,json=verseNumber,proto3" json:"verse_number,omitempty"`
+	Text          string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Lyric) Reset() {
+	*x = Lyric{}
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Lyric) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lyric) ProtoMessage() {}
+
+func (x *Lyric) ProtoReflect() protoreflect.Message {
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lyric.ProtoReflect.Descriptor instead.
+func (*Lyric) Descriptor() ([]byte, []int) {
+	return file_musiclib_v1_musiclib_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Lyric) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Lyric) GetSongId() uint64 {
+	if x != nil {
+		return x.SongId
+	}
+	return 0
+}
+
+func (x *Lyric) GetVerseNumber() int32 {
+	if x != nil {
+		return x.VerseNumber
+	}
+	return 0
+}
+
+func (x *Lyric) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type Pagination struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Pagination) Reset() {
+	*x = Pagination{}
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pagination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pagination) ProtoMessage() {}
+
+func (x *Pagination) ProtoReflect() protoreflect.Message {
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pagination.ProtoReflect.Descriptor instead.
+func (*Pagination) Descriptor() ([]byte, []int) {
+	return file_musiclib_v1_musiclib_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Pagination) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *Pagination) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *Pagination) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// SongFilter takes the same filters as GET /songs. Empty fields are ignored.
+type SongFilter struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Group       string                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	ReleaseDate string                 `protobuf:"bytes,3,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
+	Link        string                 `protobuf:"bytes,4,opt,name=link,proto3" json:"link,omitempty"`
+	// Inclusive bounds; a year or month covers the whole period.
+	ReleasedAfter  string `protobuf:"bytes,5,opt,name=released_after,json=releasedAfter,proto3" json:"released_after,omitempty"`
+	ReleasedBefore string `protobuf:"bytes,6,opt,name=released_before,json=releasedBefore,proto3" json:"released_before,omitempty"`
+	Year           int32  `protobuf:"varint,7,opt,name=year,proto3" json:"year,omitempty"`
+	// At most 100 IDs.
+	Ids           []uint64 `protobuf:"varint,8,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SongFilter) Reset() {
+	*x = SongFilter{}
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SongFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SongFilter) ProtoMessage() {}
+
+func (x *SongFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SongFilter.ProtoReflect.Descriptor instead.
+func (*SongFilter) Descriptor() ([]byte, []int) {
+	return file_musiclib_v1_musiclib_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SongFilter) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *SongFilter) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SongFilter) GetReleaseDate() string {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return ""
+}
+
+func (x *SongFilter) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *SongFilter) GetReleasedAfter() string {
+	if x != nil {
+		return x.ReleasedAfter
+	}
+	return ""
+}
+
+func (x *SongFilter) GetReleasedBefore() string {
+	if x != nil {
+		return x.ReleasedBefore
+	}
+	return ""
+}
+
+func (x *SongFilter) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *SongFilter) GetIds() []uint64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type GetSongRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSongRequest) Reset() {
+	*x = GetSongRequest{}
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSongRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSongRequest) ProtoMessage() {}
+
+func (x *GetSongRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSongRequest.ProtoReflect.Descriptor instead.
+func (*GetSongRequest) Descriptor() ([]byte, []int) {
+	return file_musiclib_v1_musiclib_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetSongRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListSongsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Filter *SongFilter            `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Offset int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Defaults to 10, at most 100. A negative offset or page_size is rejected with INVALID_ARGUMENT.
+	PageSize int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Comma-separated fields, "-" for descending order, e.g. "-release_date,title".
+	// Allowed: id, group, title, release_date, link, lyric_count. Ties are broken by ID.
+	Sort          string `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSongsRequest) Reset() {
+	*x = ListSongsRequest{}
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSongsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSongsRequest) ProtoMessage() {}
+
+func (x *ListSongsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSongsRequest.ProtoReflect.Descriptor instead.
+func (*ListSongsRequest) Descriptor() ([]byte, []int) {
+	return file_musiclib_v1_musiclib_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListSongsRequest) GetFilter() *SongFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListSongsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListSongsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSongsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+type ListSongsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Songs         []*Song                `protobuf:"bytes,1,rep,name=songs,proto3" json:"songs,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSongsResponse) Reset() {
+	*x = ListSongsResponse{}
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSongsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSongsResponse) ProtoMessage() {}
+
+func (x *ListSongsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSongsResponse.ProtoReflect.Descriptor instead.
+func (*ListSongsResponse) Descriptor() ([]byte, []int) {
+	return file_musiclib_v1_musiclib_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListSongsResponse) GetSongs() []*Song {
+	if x != nil {
+		return x.Songs
+	}
+	return nil
+}
+
+func (x *ListSongsResponse) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListAllSongsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *SongFilter            `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAllSongsRequest) Reset() {
+	*x = ListAllSongsRequest{}
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAllSongsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllSongsRequest) ProtoMessage() {}
+
+func (x *ListAllSongsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllSongsRequest.ProtoReflect.Descriptor instead.
+func (*ListAllSongsRequest) Descriptor() ([]byte, []int) {
+	return file_musiclib_v1_musiclib_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListAllSongsRequest) GetFilter() *SongFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type CreateSongRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Song          *Song                  `protobuf:"bytes,1,opt,name=song,proto3" json:"song,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSongRequest) Reset() {
+	*x = CreateSongRequest{}
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSongRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSongRequest) ProtoMessage() {}
+
+func (x *CreateSongRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSongRequest.ProtoReflect.Descriptor instead.
+func (*CreateSongRequest) Descriptor() ([]byte, []int) {
+	return file_musiclib_v1_musiclib_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateSongRequest) GetSong() *Song {
+	if x != nil {
+		return x.Song
+	}
+	return nil
+}
+
+// UpdateSongRequest changes the non-empty fields of the song.
+type UpdateSongRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Song          *Song                  `protobuf:"bytes,2,opt,name=song,proto3" json:"song,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSongRequest) Reset() {
+	*x = UpdateSongRequest{}
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSongRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSongRequest) ProtoMessage() {}
+
+func (x *UpdateSongRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSongRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSongRequest) Descriptor() ([]byte, []int) {
+	return file_musiclib_v1_musiclib_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateSongRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateSongRequest) GetSong() *Song {
+	if x != nil {
+		return x.Song
+	}
+	return nil
+}
+
+type DeleteSongRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSongRequest) Reset() {
+	*x = DeleteSongRequest{}
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSongRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSongRequest) ProtoMessage() {}
+
+func (x *DeleteSongRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSongRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSongRequest) Descriptor() ([]byte, []int) {
+	return file_musiclib_v1_musiclib_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteSongRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteSongResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSongResponse) Reset() {
+	*x = DeleteSongResponse{}
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSongResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSongResponse) ProtoMessage() {}
+
+func (x *DeleteSongResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSongResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSongResponse) Descriptor() ([]byte, []int) {
+	return file_musiclib_v1_musiclib_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteSongResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetLyricRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLyricRequest) Reset() {
+	*x = GetLyricRequest{}
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLyricRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLyricRequest) ProtoMessage() {}
+
+func (x *GetLyricRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLyricRequest.ProtoReflect.Descriptor instead.
+func (*GetLyricRequest) Descriptor() ([]byte, []int) {
+	return file_musiclib_v1_musiclib_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetLyricRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// LyricFilter selects verses of one song when song_id is set.
+type LyricFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SongId        uint64                 `protobuf:"varint,1,opt,name=song_id,json=songId,proto3" json:"song_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LyricFilter) Reset() {
+	*x = LyricFilter{}
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LyricFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LyricFilter) ProtoMessage() {}
+
+func (x *LyricFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LyricFilter.ProtoReflect.Descriptor instead.
+func (*LyricFilter) Descriptor() ([]byte, []int) {
+	return file_musiclib_v1_musiclib_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *LyricFilter) GetSongId() uint64 {
+	if x != nil {
+		return x.SongId
+	}
+	return 0
+}
+
+type ListLyricsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Filter *LyricFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Offset int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Defaults to 10, at most 100. A negative offset or page_size is rejected with INVALID_ARGUMENT.
+	PageSize      int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLyricsRequest) Reset() {
+	*x = ListLyricsRequest{}
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLyricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLyricsRequest) ProtoMessage() {}
+
+func (x *ListLyricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLyricsRequest.ProtoReflect.Descriptor instead.
+func (*ListLyricsRequest) Descriptor() ([]byte, []int) {
+	return file_musiclib_v1_musiclib_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListLyricsRequest) GetFilter() *LyricFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListLyricsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListLyricsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListLyricsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lyrics        []*Lyric               `protobuf:"bytes,1,rep,name=lyrics,proto3" json:"lyrics,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLyricsResponse) Reset() {
+	*x = ListLyricsResponse{}
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLyricsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLyricsResponse) ProtoMessage() {}
+
+func (x *ListLyricsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLyricsResponse.ProtoReflect.Descriptor instead.
+func (*ListLyricsResponse) Descriptor() ([]byte, []int) {
+	return file_musiclib_v1_musiclib_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListLyricsResponse) GetLyrics() []*Lyric {
+	if x != nil {
+		return x.Lyrics
+	}
+	return nil
+}
+
+func (x *ListLyricsResponse) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListAllLyricsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *LyricFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAllLyricsRequest) Reset() {
+	*x = ListAllLyricsRequest{}
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAllLyricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllLyricsRequest) ProtoMessage() {}
+
+func (x *ListAllLyricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllLyricsRequest.ProtoReflect.Descriptor instead.
+func (*ListAllLyricsRequest) Descriptor() ([]byte, []int) {
+	return file_musiclib_v1_musiclib_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListAllLyricsRequest) GetFilter() *LyricFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type CreateLyricRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lyric         *Lyric                 `protobuf:"bytes,1,opt,name=lyric,proto3" json:"lyric,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLyricRequest) Reset() {
+	*x = CreateLyricRequest{}
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLyricRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLyricRequest) ProtoMessage() {}
+
+func (x *CreateLyricRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLyricRequest.ProtoReflect.Descriptor instead.
+func (*CreateLyricRequest) Descriptor() ([]byte, []int) {
+	return file_musiclib_v1_musiclib_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateLyricRequest) GetLyric() *Lyric {
+	if x != nil {
+		return x.Lyric
+	}
+	return nil
+}
+
+// UpdateLyricRequest changes the non-empty fields of the verse.
+type UpdateLyricRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Lyric         *Lyric                 `protobuf:"bytes,2,opt,name=lyric,proto3" json:"lyric,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLyricRequest) Reset() {
+	*x = UpdateLyricRequest{}
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLyricRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLyricRequest) ProtoMessage() {}
+
+func (x *UpdateLyricRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLyricRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLyricRequest) Descriptor() ([]byte, []int) {
+	return file_musiclib_v1_musiclib_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateLyricRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateLyricRequest) GetLyric() *Lyric {
+	if x != nil {
+		return x.Lyric
+	}
+	return nil
+}
+
+type DeleteLyricRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLyricRequest) Reset() {
+	*x = DeleteLyricRequest{}
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLyricRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLyricRequest) ProtoMessage() {}
+
+func (x *DeleteLyricRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLyricRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLyricRequest) Descriptor() ([]byte, []int) {
+	return file_musiclib_v1_musiclib_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DeleteLyricRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteLyricResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLyricResponse) Reset() {
+	*x = DeleteLyricResponse{}
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLyricResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLyricResponse) ProtoMessage() {}
+
+func (x *DeleteLyricResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_musiclib_v1_musiclib_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLyricResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLyricResponse) Descriptor() ([]byte, []int) {
+	return file_musiclib_v1_musiclib_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeleteLyricResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+var File_musiclib_v1_musiclib_proto protoreflect.FileDescriptor
+
+var file_musiclib_v1_musiclib_proto_rawDesc = string([]byte{
+	0x0a, 0x1a, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x6c, 0x69, 0x62, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x75,
+	0x73, 0x69, 0x63, 0x6c, 0x69, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x6d, 0x75,
+	0x73, 0x69, 0x63, 0x6c, 0x69, 0x62, 0x2e, 0x76, 0x31, 0x22, 0xa5, 0x01, 0x0a, 0x04, 0x53, 0x6f,
+	0x6e, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x2a, 0x0a, 0x06, 0x6c, 0x79, 0x72, 0x69, 0x63, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x6c, 0x69, 0x62,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x52, 0x06, 0x6c, 0x79, 0x72, 0x69, 0x63,
+	0x73, 0x22, 0x67, 0x0a, 0x05, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x6f,
+	0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x6f, 0x6e,
+	0x67, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x57, 0x0a, 0x0a, 0x50, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x22, 0xe5, 0x01, 0x0a, 0x0a, 0x53, 0x6f, 0x6e, 0x67, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x42,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x04, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x20, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x8c, 0x01,
+	0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x6c, 0x69, 0x62, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x22, 0x75, 0x0a, 0x11,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x27, 0x0a, 0x05, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x6c, 0x69, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x6f, 0x6e, 0x67, 0x52, 0x05, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x12, 0x37, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x6c, 0x69, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x46, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x6f,
+	0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x75, 0x73,
+	0x69, 0x63, 0x6c, 0x69, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x3a, 0x0a, 0x11, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x25, 0x0a, 0x04, 0x73, 0x6f, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x6c, 0x69, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6e,
+	0x67, 0x52, 0x04, 0x73, 0x6f, 0x6e, 0x67, 0x22, 0x4a, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x04,
+	0x73, 0x6f, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x75, 0x73,
+	0x69, 0x63, 0x6c, 0x69, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x04, 0x73,
+	0x6f, 0x6e, 0x67, 0x22, 0x23, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6f, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x24, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x21,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x26, 0x0a, 0x0b, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x17, 0x0a, 0x07, 0x73, 0x6f, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x73, 0x6f, 0x6e, 0x67, 0x49, 0x64, 0x22, 0x7a, 0x0a, 0x11, 0x4c, 0x69, 0x73,
+	0x74, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30,
+	0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x6c, 0x69, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x79, 0x72,
+	0x69, 0x63, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x79, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x79, 0x72,
+	0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x6c,
+	0x79, 0x72, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x75,
+	0x73, 0x69, 0x63, 0x6c, 0x69, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x52,
+	0x06, 0x6c, 0x79, 0x72, 0x69, 0x63, 0x73, 0x12, 0x37, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x75,
+	0x73, 0x69, 0x63, 0x6c, 0x69, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x48, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x4c, 0x79, 0x72, 0x69, 0x63,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x75, 0x73, 0x69, 0x63,
+	0x6c, 0x69, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x3e, 0x0a, 0x12, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x28, 0x0a, 0x05, 0x6c, 0x79, 0x72, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x6c, 0x69, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x79,
+	0x72, 0x69, 0x63, 0x52, 0x05, 0x6c, 0x79, 0x72, 0x69, 0x63, 0x22, 0x4e, 0x0a, 0x12, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x28, 0x0a, 0x05, 0x6c, 0x79, 0x72, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x6c, 0x69, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x79,
+	0x72, 0x69, 0x63, 0x52, 0x05, 0x6c, 0x79, 0x72, 0x69, 0x63, 0x22, 0x24, 0x0a, 0x12, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x25, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x32, 0xac, 0x03, 0x0a, 0x0b, 0x53, 0x6f, 0x6e, 0x67,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x53, 0x6f,
+	0x6e, 0x67, 0x12, 0x1b, 0x2e, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x6c, 0x69, 0x62, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x11, 0x2e, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x6c, 0x69, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f,
+	0x6e, 0x67, 0x12, 0x4a, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x12,
+	0x1d, 0x2e, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x6c, 0x69, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x6c, 0x69, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45,
+	0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x12, 0x20,
+	0x2e, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x6c, 0x69, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x6c, 0x6c, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x11, 0x2e, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x6c, 0x69, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x6f, 0x6e, 0x67, 0x30, 0x01, 0x12, 0x3f, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x6f, 0x6e, 0x67, 0x12, 0x1e, 0x2e, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x6c, 0x69, 0x62, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x6c, 0x69, 0x62, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x12, 0x3f, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x6f, 0x6e, 0x67, 0x12, 0x1e, 0x2e, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x6c, 0x69, 0x62, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x6c, 0x69, 0x62, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x12, 0x4d, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x53, 0x6f, 0x6e, 0x67, 0x12, 0x1e, 0x2e, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x6c, 0x69, 0x62,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x6c, 0x69, 0x62,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xbf, 0x03, 0x0a, 0x0c, 0x4c, 0x79, 0x72, 0x69, 0x63,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x4c, 0x79,
+	0x72, 0x69, 0x63, 0x12, 0x1c, 0x2e, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x6c, 0x69, 0x62, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x12, 0x2e, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x6c, 0x69, 0x62, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x79, 0x72, 0x69, 0x63, 0x12, 0x4d, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x79, 0x72,
+	0x69, 0x63, 0x73, 0x12, 0x1e, 0x2e, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x6c, 0x69, 0x62, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x6c, 0x69, 0x62, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x4c,
+	0x79, 0x72, 0x69, 0x63, 0x73, 0x12, 0x21, 0x2e, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x6c, 0x69, 0x62,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x4c, 0x79, 0x72, 0x69, 0x63,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6d, 0x75, 0x73, 0x69, 0x63,
+	0x6c, 0x69, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x30, 0x01, 0x12, 0x42,
+	0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x12, 0x1f, 0x2e,
+	0x6d, 0x75, 0x73, 0x69, 0x63, 0x6c, 0x69, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12,
+	0x2e, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x6c, 0x69, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x79, 0x72,
+	0x69, 0x63, 0x12, 0x42, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x79, 0x72, 0x69,
+	0x63, 0x12, 0x1f, 0x2e, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x6c, 0x69, 0x62, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x6c, 0x69, 0x62, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x12, 0x50, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x4c, 0x79, 0x72, 0x69, 0x63, 0x12, 0x1f, 0x2e, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x6c, 0x69, 0x62,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x6c, 0x69,
+	0x62, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x79, 0x72, 0x69, 0x63,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2a, 0x5a, 0x28, 0x4d, 0x75, 0x73, 0x69,
+	0x63, 0x5f, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x75,
+	0x73, 0x69, 0x63, 0x6c, 0x69, 0x62, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x6c,
+	0x69, 0x62, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_musiclib_v1_musiclib_proto_rawDescOnce sync.Once
+	file_musiclib_v1_musiclib_proto_rawDescData []byte
+)
+
+func file_musiclib_v1_musiclib_proto_rawDescGZIP() []byte {
+	file_musiclib_v1_musiclib_proto_rawDescOnce.Do(func() {
+		file_musiclib_v1_musiclib_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_musiclib_v1_musiclib_proto_rawDesc), len(file_musiclib_v1_musiclib_proto_rawDesc)))
+	})
+	return file_musiclib_v1_musiclib_proto_rawDescData
+}
+
+var file_musiclib_v1_musiclib_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_musiclib_v1_musiclib_proto_goTypes = []any{
+	(*Song)(nil),                 // 0: musiclib.v1.Song
+	(*Lyric)(nil),                // 1: musiclib.v1.Lyric
+	(*Pagination)(nil),           // 2: musiclib.v1.Pagination
+	(*SongFilter)(nil),           // 3: musiclib.v1.SongFilter
+	(*GetSongRequest)(nil),       // 4: musiclib.v1.GetSongRequest
+	(*ListSongsRequest)(nil),     // 5: musiclib.v1.ListSongsRequest
+	(*ListSongsResponse)(nil),    // 6: musiclib.v1.ListSongsResponse
+	(*ListAllSongsRequest)(nil),  // 7: musiclib.v1.ListAllSongsRequest
+	(*CreateSongRequest)(nil),    // 8: musiclib.v1.CreateSongRequest
+	(*UpdateSongRequest)(nil),    // 9: musiclib.v1.UpdateSongRequest
+	(*DeleteSongRequest)(nil),    // 10: musiclib.v1.DeleteSongRequest
+	(*DeleteSongResponse)(nil),   // 11: musiclib.v1.DeleteSongResponse
+	(*GetLyricRequest)(nil),      // 12: musiclib.v1.GetLyricRequest
+	(*LyricFilter)(nil),          // 13: musiclib.v1.LyricFilter
+	(*ListLyricsRequest)(nil),    // 14: musiclib.v1.ListLyricsRequest
+	(*ListLyricsResponse)(nil),   // 15: musiclib.v1.ListLyricsResponse
+	(*ListAllLyricsRequest)(nil), // 16: musiclib.v1.ListAllLyricsRequest
+	(*CreateLyricRequest)(nil),   // 17: musiclib.v1.CreateLyricRequest
+	(*UpdateLyricRequest)(nil),   // 18: musiclib.v1.UpdateLyricRequest
+	(*DeleteLyricRequest)(nil),   // 19: musiclib.v1.DeleteLyricRequest
+	(*DeleteLyricResponse)(nil),  // 20: musiclib.v1.DeleteLyricResponse
+}
+var file_musiclib_v1_musiclib_proto_depIdxs = []int32{
+	1,  // 0: musiclib.v1.Song.lyrics:type_name -> musiclib.v1.Lyric
+	3,  // 1: musiclib.v1.ListSongsRequest.filter:type_name -> musiclib.v1.SongFilter
+	0,  // 2: musiclib.v1.ListSongsResponse.songs:type_name -> musiclib.v1.Song
+	2,  // 3: musiclib.v1.ListSongsResponse.pagination:type_name -> musiclib.v1.Pagination
+	3,  // 4: musiclib.v1.ListAllSongsRequest.filter:type_name -> musiclib.v1.SongFilter
+	0,  // 5: musiclib.v1.CreateSongRequest.song:type_name -> musiclib.v1.Song
+	0,  // 6: musiclib.v1.UpdateSongRequest.song:type_name -> musiclib.v1.Song
+	13, // 7: musiclib.v1.ListLyricsRequest.filter:type_name -> musiclib.v1.LyricFilter
+	1,  // 8: musiclib.v1.ListLyricsResponse.lyrics:type_name -> musiclib.v1.Lyric
+	2,  // 9: musiclib.v1.ListLyricsResponse.pagination:type_name -> musiclib.v1.Pagination
+	13, // 10: musiclib.v1.ListAllLyricsRequest.filter:type_name -> musiclib.v1.LyricFilter
+	1,  // 11: musiclib.v1.CreateLyricRequest.lyric:type_name -> musiclib.v1.Lyric
+	1,  // 12: musiclib.v1.UpdateLyricRequest.lyric:type_name -> musiclib.v1.Lyric
+	4,  // 13: musiclib.v1.SongService.GetSong:input_type -> musiclib.v1.GetSongRequest
+	5,  // 14: musiclib.v1.SongService.ListSongs:input_type -> musiclib.v1.ListSongsRequest
+	7,  // 15: musiclib.v1.SongService.ListAllSongs:input_type -> musiclib.v1.ListAllSongsRequest
+	8,  // 16: musiclib.v1.SongService.CreateSong:input_type -> musiclib.v1.CreateSongRequest
+	9,  // 17: musiclib.v1.SongService.UpdateSong:input_type -> musiclib.v1.UpdateSongRequest
+	10, // 18: musiclib.v1.SongService.DeleteSong:input_type -> musiclib.v1.DeleteSongRequest
+	12, // 19: musiclib.v1.LyricService.GetLyric:input_type -> musiclib.v1.GetLyricRequest
+	14, // 20: musiclib.v1.LyricService.ListLyrics:input_type -> musiclib.v1.ListLyricsRequest
+	16, // 21: musiclib.v1.LyricService.ListAllLyrics:input_type -> musiclib.v1.ListAllLyricsRequest
+	17, // 22: musiclib.v1.LyricService.CreateLyric:input_type -> musiclib.v1.CreateLyricRequest
+	18, // 23: musiclib.v1.LyricService.UpdateLyric:input_type -> musiclib.v1.UpdateLyricRequest
+	19, // 24: musiclib.v1.LyricService.DeleteLyric:input_type -> musiclib.v1.DeleteLyricRequest
+	0,  // 25: musiclib.v1.SongService.GetSong:output_type -> musiclib.v1.Song
+	6,  // 26: musiclib.v1.SongService.ListSongs:output_type -> musiclib.v1.ListSongsResponse
+	0,  // 27: musiclib.v1.SongService.ListAllSongs:output_type -> musiclib.v1.Song
+	0,  // 28: musiclib.v1.SongService.CreateSong:output_type -> musiclib.v1.Song
+	0,  // 29: musiclib.v1.SongService.UpdateSong:output_type -> musiclib.v1.Song
+	11, // 30: musiclib.v1.SongService.DeleteSong:output_type -> musiclib.v1.DeleteSongResponse
+	1,  // 31: musiclib.v1.LyricService.GetLyric:output_type -> musiclib.v1.Lyric
+	15, // 32: musiclib.v1.LyricService.ListLyrics:output_type -> musiclib.v1.ListLyricsResponse
+	1,  // 33: musiclib.v1.LyricService.ListAllLyrics:output_type -> musiclib.v1.Lyric
+	1,  // 34: musiclib.v1.LyricService.CreateLyric:output_type -> musiclib.v1.Lyric
+	1,  // 35: musiclib.v1.LyricService.UpdateLyric:output_type -> musiclib.v1.Lyric
+	20, // 36: musiclib.v1.LyricService.DeleteLyric:output_type -> musiclib.v1.DeleteLyricResponse
+	25, // [25:37] is the sub-list for method output_type
+	13, // [13:25] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_musiclib_v1_musiclib_proto_init() }
+func file_musiclib_v1_musiclib_proto_init() {
+	if File_musiclib_v1_musiclib_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_musiclib_v1_musiclib_proto_rawDesc), len(file_musiclib_v1_musiclib_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   21,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_musiclib_v1_musiclib_proto_goTypes,
+		DependencyIndexes: file_musiclib_v1_musiclib_proto_depIdxs,
+		MessageInfos:      file_musiclib_v1_musiclib_proto_msgTypes,
+	}.Build()
+	File_musiclib_v1_musiclib_proto = out.File
+	file_musiclib_v1_musiclib_proto_goTypes = nil
+	file_musiclib_v1_musiclib_proto_depIdxs = nil
+}
@@ -0,0 +1,615 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: musiclib/v1/musiclib.proto
+
+package musiclibv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SongService_GetSong_FullMethodName      = "/musiclib.v1.SongService/GetSong"
+	SongService_ListSongs_FullMethodName    = "/musiclib.v1.SongService/ListSongs"
+	SongService_ListAllSongs_FullMethodName = "/musiclib.v1.SongService/ListAllSongs"
+	SongService_CreateSong_FullMethodName   = "/musiclib.v1.SongService/CreateSong"
+	SongService_UpdateSong_FullMethodName   = "/musiclib.v1.SongService/UpdateSong"
+	SongService_DeleteSong_FullMethodName   = "/musiclib.v1.SongService/DeleteSong"
+)
+
+// SongServiceClient is the client API for SongService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SongServiceClient interface {
+	GetSong(ctx context.Context, in *GetSongRequest, opts ...grpc.CallOption) (*Song, error)
+	ListSongs(ctx context.Context, in *ListSongsRequest, opts ...grpc.CallOption) (*ListSongsResponse, error)
+	// ListAllSongs streams every song matching the filter, ordered by ID.
+	ListAllSongs(ctx context.Context, in *ListAllSongsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Song], error)
+	CreateSong(ctx context.Context, in *CreateSongRequest, opts ...grpc.CallOption) (*Song, error)
+	UpdateSong(ctx context.Context, in *UpdateSongRequest, opts ...grpc.CallOption) (*Song, error)
+	DeleteSong(ctx context.Context, in *DeleteSongRequest, opts ...grpc.CallOption) (*DeleteSongResponse, error)
+}
+
+type songServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSongServiceClient(cc grpc.ClientConnInterface) SongServiceClient {
+	return &songServiceClient{cc}
+}
+
+func (c *songServiceClient) GetSong(ctx context.Context, in *GetSongRequest, opts ...grpc.CallOption) (*Song, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Song)
+	err := c.cc.Invoke(ctx, SongService_GetSong_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) ListSongs(ctx context.Context, in *ListSongsRequest, opts ...grpc.CallOption) (*ListSongsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSongsResponse)
+	err := c.cc.Invoke(ctx, SongService_ListSongs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) ListAllSongs(ctx context.Context, in *ListAllSongsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Song], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SongService_ServiceDesc.Streams[0], SongService_ListAllSongs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListAllSongsRequest, Song]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SongService_ListAllSongsClient = grpc.ServerStreamingClient[Song]
+
+func (c *songServiceClient) CreateSong(ctx context.Context, in *CreateSongRequest, opts ...grpc.CallOption) (*Song, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Song)
+	err := c.cc.Invoke(ctx, SongService_CreateSong_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) UpdateSong(ctx context.Context, in *UpdateSongRequest, opts ...grpc.CallOption) (*Song, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Song)
+	err := c.cc.Invoke(ctx, SongService_UpdateSong_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) DeleteSong(ctx context.Context, in *DeleteSongRequest, opts ...grpc.CallOption) (*DeleteSongResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSongResponse)
+	err := c.cc.Invoke(ctx, SongService_DeleteSong_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SongServiceServer is the server API for SongService service.
+// All implementations must embed UnimplementedSongServiceServer
+// for forward compatibility.
+type SongServiceServer interface {
+	GetSong(context.Context, *GetSongRequest) (*Song, error)
+	ListSongs(context.Context, *ListSongsRequest) (*ListSongsResponse, error)
+	// ListAllSongs streams every song matching the filter, ordered by ID.
+	ListAllSongs(*ListAllSongsRequest, grpc.ServerStreamingServer[Song]) error
+	CreateSong(context.Context, *CreateSongRequest) (*Song, error)
+	UpdateSong(context.Context, *UpdateSongRequest) (*Song, error)
+	DeleteSong(context.Context, *DeleteSongRequest) (*DeleteSongResponse, error)
+	mustEmbedUnimplementedSongServiceServer()
+}
+
+// UnimplementedSongServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSongServiceServer struct{}
+
+func (UnimplementedSongServiceServer) GetSong(context.Context, *GetSongRequest) (*Song, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSong not implemented")
+}
+func (UnimplementedSongServiceServer) ListSongs(context.Context, *ListSongsRequest) (*ListSongsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSongs not implemented")
+}
+func (UnimplementedSongServiceServer) ListAllSongs(*ListAllSongsRequest, grpc.ServerStreamingServer[Song]) error {
+	return status.Errorf(codes.Unimplemented, "method ListAllSongs not implemented")
+}
+func (UnimplementedSongServiceServer) CreateSong(context.Context, *CreateSongRequest) (*Song, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSong not implemented")
+}
+func (UnimplementedSongServiceServer) UpdateSong(context.Context, *UpdateSongRequest) (*Song, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSong not implemented")
+}
+func (UnimplementedSongServiceServer) DeleteSong(context.Context, *DeleteSongRequest) (*DeleteSongResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSong not implemented")
+}
+func (UnimplementedSongServiceServer) mustEmbedUnimplementedSongServiceServer() {}
+func (UnimplementedSongServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeSongServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SongServiceServer will
+// result in compilation errors.
+type UnsafeSongServiceServer interface {
+	mustEmbedUnimplementedSongServiceServer()
+}
+
+func RegisterSongServiceServer(s grpc.ServiceRegistrar, srv SongServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSongServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SongService_ServiceDesc, srv)
+}
+
+func _SongService_GetSong_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSongRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).GetSong(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_GetSong_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).GetSong(ctx, req.(*GetSongRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_ListSongs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSongsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).ListSongs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_ListSongs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).ListSongs(ctx, req.(*ListSongsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_ListAllSongs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListAllSongsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SongServiceServer).ListAllSongs(m, &grpc.GenericServerStream[ListAllSongsRequest, Song]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SongService_ListAllSongsServer = grpc.ServerStreamingServer[Song]
+
+func _SongService_CreateSong_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSongRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).CreateSong(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_CreateSong_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).CreateSong(ctx, req.(*CreateSongRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_UpdateSong_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSongRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).UpdateSong(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_UpdateSong_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).UpdateSong(ctx, req.(*UpdateSongRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_DeleteSong_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSongRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).DeleteSong(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_DeleteSong_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).DeleteSong(ctx, req.(*DeleteSongRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SongService_ServiceDesc is the grpc.ServiceDesc for SongService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SongService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "musiclib.v1.SongService",
+	HandlerType: (*SongServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSong",
+			Handler:    _SongService_GetSong_Handler,
+		},
+		{
+			MethodName: "ListSongs",
+			Handler:    _SongService_ListSongs_Handler,
+		},
+		{
+			MethodName: "CreateSong",
+			Handler:    _SongService_CreateSong_Handler,
+		},
+		{
+			MethodName: "UpdateSong",
+			Handler:    _SongService_UpdateSong_Handler,
+		},
+		{
+			MethodName: "DeleteSong",
+			Handler:    _SongService_DeleteSong_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListAllSongs",
+			Handler:       _SongService_ListAllSongs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "musiclib/v1/musiclib.proto",
+}
+
+const (
+	LyricService_GetLyric_FullMethodName      = "/musiclib.v1.LyricService/GetLyric"
+	LyricService_ListLyrics_FullMethodName    = "/musiclib.v1.LyricService/ListLyrics"
+	LyricService_ListAllLyrics_FullMethodName = "/musiclib.v1.LyricService/ListAllLyrics"
+	LyricService_CreateLyric_FullMethodName   = "/musiclib.v1.LyricService/CreateLyric"
+	LyricService_UpdateLyric_FullMethodName   = "/musiclib.v1.LyricService/UpdateLyric"
+	LyricService_DeleteLyric_FullMethodName   = "/musiclib.v1.LyricService/DeleteLyric"
+)
+
+// LyricServiceClient is the client API for LyricService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LyricServiceClient interface {
+	GetLyric(ctx context.Context, in *GetLyricRequest, opts ...grpc.CallOption) (*Lyric, error)
+	ListLyrics(ctx context.Context, in *ListLyricsRequest, opts ...grpc.CallOption) (*ListLyricsResponse, error)
+	// ListAllLyrics streams every verse matching the filter, ordered by song and verse number.
+	ListAllLyrics(ctx context.Context, in *ListAllLyricsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Lyric], error)
+	CreateLyric(ctx context.Context, in *CreateLyricRequest, opts ...grpc.CallOption) (*Lyric, error)
+	UpdateLyric(ctx context.Context, in *UpdateLyricRequest, opts ...grpc.CallOption) (*Lyric, error)
+	DeleteLyric(ctx context.Context, in *DeleteLyricRequest, opts ...grpc.CallOption) (*DeleteLyricResponse, error)
+}
+
+type lyricServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLyricServiceClient(cc grpc.ClientConnInterface) LyricServiceClient {
+	return &lyricServiceClient{cc}
+}
+
+func (c *lyricServiceClient) GetLyric(ctx context.Context, in *GetLyricRequest, opts ...grpc.CallOption) (*Lyric, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Lyric)
+	err := c.cc.Invoke(ctx, LyricService_GetLyric_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lyricServiceClient) ListLyrics(ctx context.Context, in *ListLyricsRequest, opts ...grpc.CallOption) (*ListLyricsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLyricsResponse)
+	err := c.cc.Invoke(ctx, LyricService_ListLyrics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lyricServiceClient) ListAllLyrics(ctx context.Context, in *ListAllLyricsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Lyric], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &LyricService_ServiceDesc.Streams[0], LyricService_ListAllLyrics_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListAllLyricsRequest, Lyric]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LyricService_ListAllLyricsClient = grpc.ServerStreamingClient[Lyric]
+
+func (c *lyricServiceClient) CreateLyric(ctx context.Context, in *CreateLyricRequest, opts ...grpc.CallOption) (*Lyric, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Lyric)
+	err := c.cc.Invoke(ctx, LyricService_CreateLyric_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lyricServiceClient) UpdateLyric(ctx context.Context, in *UpdateLyricRequest, opts ...grpc.CallOption) (*Lyric, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Lyric)
+	err := c.cc.Invoke(ctx, LyricService_UpdateLyric_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lyricServiceClient) DeleteLyric(ctx context.Context, in *DeleteLyricRequest, opts ...grpc.CallOption) (*DeleteLyricResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteLyricResponse)
+	err := c.cc.Invoke(ctx, LyricService_DeleteLyric_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LyricServiceServer is the server API for LyricService service.
+// All implementations must embed UnimplementedLyricServiceServer
+// for forward compatibility.
+type LyricServiceServer interface {
+	GetLyric(context.Context, *GetLyricRequest) (*Lyric, error)
+	ListLyrics(context.Context, *ListLyricsRequest) (*ListLyricsResponse, error)
+	// ListAllLyrics streams every verse matching the filter, ordered by song and verse number.
+	ListAllLyrics(*ListAllLyricsRequest, grpc.ServerStreamingServer[Lyric]) error
+	CreateLyric(context.Context, *CreateLyricRequest) (*Lyric, error)
+	UpdateLyric(context.Context, *UpdateLyricRequest) (*Lyric, error)
+	DeleteLyric(context.Context, *DeleteLyricRequest) (*DeleteLyricResponse, error)
+	mustEmbedUnimplementedLyricServiceServer()
+}
+
+// UnimplementedLyricServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLyricServiceServer struct{}
+
+func (UnimplementedLyricServiceServer) GetLyric(context.Context, *GetLyricRequest) (*Lyric, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLyric not implemented")
+}
+func (UnimplementedLyricServiceServer) ListLyrics(context.Context, *ListLyricsRequest) (*ListLyricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLyrics not implemented")
+}
+func (UnimplementedLyricServiceServer) ListAllLyrics(*ListAllLyricsRequest, grpc.ServerStreamingServer[Lyric]) error {
+	return status.Errorf(codes.Unimplemented, "method ListAllLyrics not implemented")
+}
+func (UnimplementedLyricServiceServer) CreateLyric(context.Context, *CreateLyricRequest) (*Lyric, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLyric not implemented")
+}
+func (UnimplementedLyricServiceServer) UpdateLyric(context.Context, *UpdateLyricRequest) (*Lyric, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLyric not implemented")
+}
+func (UnimplementedLyricServiceServer) DeleteLyric(context.Context, *DeleteLyricRequest) (*DeleteLyricResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLyric not implemented")
+}
+func (UnimplementedLyricServiceServer) mustEmbedUnimplementedLyricServiceServer() {}
+func (UnimplementedLyricServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeLyricServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LyricServiceServer will
+// result in compilation errors.
+type UnsafeLyricServiceServer interface {
+	mustEmbedUnimplementedLyricServiceServer()
+}
+
+func RegisterLyricServiceServer(s grpc.ServiceRegistrar, srv LyricServiceServer) {
+	// If the following call pancis, it indicates UnimplementedLyricServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&LyricService_ServiceDesc, srv)
+}
+
+func _LyricService_GetLyric_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLyricRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LyricServiceServer).GetLyric(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LyricService_GetLyric_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LyricServiceServer).GetLyric(ctx, req.(*GetLyricRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LyricService_ListLyrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLyricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LyricServiceServer).ListLyrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LyricService_ListLyrics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LyricServiceServer).ListLyrics(ctx, req.(*ListLyricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LyricService_ListAllLyrics_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListAllLyricsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LyricServiceServer).ListAllLyrics(m, &grpc.GenericServerStream[ListAllLyricsRequest, Lyric]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LyricService_ListAllLyricsServer = grpc.ServerStreamingServer[Lyric]
+
+func _LyricService_CreateLyric_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLyricRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LyricServiceServer).CreateLyric(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LyricService_CreateLyric_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LyricServiceServer).CreateLyric(ctx, req.(*CreateLyricRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LyricService_UpdateLyric_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLyricRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LyricServiceServer).UpdateLyric(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LyricService_UpdateLyric_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LyricServiceServer).UpdateLyric(ctx, req.(*UpdateLyricRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LyricService_DeleteLyric_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLyricRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LyricServiceServer).DeleteLyric(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LyricService_DeleteLyric_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LyricServiceServer).DeleteLyric(ctx, req.(*DeleteLyricRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LyricService_ServiceDesc is the grpc.ServiceDesc for LyricService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LyricService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "musiclib.v1.LyricService",
+	HandlerType: (*LyricServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetLyric",
+			Handler:    _LyricService_GetLyric_Handler,
+		},
+		{
+			MethodName: "ListLyrics",
+			Handler:    _LyricService_ListLyrics_Handler,
+		},
+		{
+			MethodName: "CreateLyric",
+			Handler:    _LyricService_CreateLyric_Handler,
+		},
+		{
+			MethodName: "UpdateLyric",
+			Handler:    _LyricService_UpdateLyric_Handler,
+		},
+		{
+			MethodName: "DeleteLyric",
+			Handler:    _LyricService_DeleteLyric_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListAllLyrics",
+			Handler:       _LyricService_ListAllLyrics_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "musiclib/v1/musiclib.proto",
+}
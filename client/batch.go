@@ -0,0 +1,22 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// Batch выполняет операции над песнями и куплетами одним запросом. Результаты возвращаются
+// в порядке операций; если атомарный пакет откатился, Committed равен false, а ошибка
+// не возвращается — её статус и текст есть в результате неудавшейся операции.
+// Пакет не повторяется без RetryPOST, как и другие запросы POST.
+func (c *Client) Batch(ctx context.Context, batch BatchRequest) (*BatchResponse, error) {
+	r, err := jsonRequest(http.MethodPost, "/batch", batch)
+	if err != nil {
+		return nil, err
+	}
+	var resp BatchResponse
+	if err = c.call(ctx, r, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,237 @@
+// Package client — типизированный Go-клиент для REST API музыкальной библиотеки.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	requestIDHeader    = "X-Request-ID"
+	problemContentType = "application/problem+json"
+)
+
+// Pagination описывает страницу списка.
+type Pagination struct {
+	Total    int64 `json:"total"`
+	Offset   int   `json:"offset"`
+	PageSize int   `json:"page_size"`
+}
+
+// RetryPolicy задаёт повторы запросов при сетевых ошибках и ответах 429 и 5xx.
+// Запросы POST и PATCH повторяются, только если RetryPOST равен true: JSON Patch, применённый
+// дважды, может изменить ресурс дважды.
+type RetryPolicy struct {
+	MaxAttempts int
+	Backoff     time.Duration
+	MaxBackoff  time.Duration
+	RetryPOST   bool
+}
+
+// DefaultRetryPolicy используется, если политика не задана явно.
+var DefaultRetryPolicy = RetryPolicy{MaxAttempts: 3, Backoff: 200 * time.Millisecond, MaxBackoff: 5 * time.Second}
+
+// Client выполняет запросы к API. Его можно использовать из нескольких горутин.
+type Client struct {
+	baseURL   *url.URL
+	http      *http.Client
+	retry     RetryPolicy
+	userAgent string
+}
+
+type Option func(*Client)
+
+// WithHTTPClient задаёт http.Client, например с таймаутом или своим транспортом.
+func WithHTTPClient(h *http.Client) Option {
+	return func(c *Client) { c.http = h }
+}
+
+// WithRetry задаёт политику повторов. MaxAttempts, равный 1, отключает повторы.
+func WithRetry(p RetryPolicy) Option {
+	return func(c *Client) { c.retry = p }
+}
+
+// WithUserAgent задаёт заголовок User-Agent.
+func WithUserAgent(ua string) Option {
+	return func(c *Client) { c.userAgent = ua }
+}
+
+type requestIDKey struct{}
+
+// WithRequestID задаёт идентификатор, который уйдёт в заголовке X-Request-ID всех запросов с этим
+// контекстом, включая повторы. Сервер пишет его в свои логи, что помогает связать их с логами клиента.
+// Без него сервер генерирует идентификатор сам и возвращает его в APIError.RequestID.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// New создаёт клиент для API по адресу baseURL, например "http://localhost:8080".
+func New(baseURL string, opts ...Option) (*Client, error) {
+	u, err := url.Parse(strings.TrimRight(baseURL, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("parse base URL: %w", err)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("base URL %q must be absolute", baseURL)
+	}
+	c := &Client{
+		baseURL:   u,
+		http:      http.DefaultClient,
+		retry:     DefaultRetryPolicy,
+		userAgent: "music-library-go-client",
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c, nil
+}
+
+// request описывает один вызов API. Тело хранится целиком, чтобы его можно было отправить повторно.
+type request struct {
+	method      string
+	path        string
+	query       url.Values
+	body        []byte
+	contentType string
+	header      http.Header
+}
+
+func jsonRequest(method, path string, v any) (*request, error) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return &request{method: method, path: path, body: body, contentType: "application/json"}, nil
+}
+
+// PatchOp — одна операция JSON Patch (RFC 6902).
+type PatchOp struct {
+	Op    string `json:"op"`
+	Path  string `json:"path"`
+	From  string `json:"from,omitempty"`
+	Value any    `json:"value"`
+}
+
+// mergePatchRequest собирает запрос PATCH с JSON Merge Patch (RFC 7396).
+func mergePatchRequest(path string, patch map[string]any) (*request, error) {
+	r, err := jsonRequest(http.MethodPatch, path, patch)
+	if r != nil {
+		r.contentType = "application/merge-patch+json"
+	}
+	return r, err
+}
+
+// jsonPatchRequest собирает запрос PATCH с операциями JSON Patch.
+func jsonPatchRequest(path string, ops []PatchOp) (*request, error) {
+	r, err := jsonRequest(http.MethodPatch, path, ops)
+	if r != nil {
+		r.contentType = "application/json-patch+json"
+	}
+	return r, err
+}
+
+// do выполняет запрос с повторами и возвращает успешный ответ. Тело ответа закрывает вызывающий.
+func (c *Client) do(ctx context.Context, r *request) (*http.Response, error) {
+	attempts := max(c.retry.MaxAttempts, 1)
+	if (r.method == http.MethodPost || r.method == http.MethodPatch) && !c.retry.RetryPOST {
+		attempts = 1
+	}
+
+	var lastErr error
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			if err := c.wait(ctx, attempt); err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := c.send(ctx, r)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			lastErr = err
+			continue
+		}
+		if resp.StatusCode < 400 {
+			return resp, nil
+		}
+		lastErr = decodeError(resp)
+		if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode < 500 {
+			return nil, lastErr
+		}
+	}
+	return nil, lastErr
+}
+
+func (c *Client) send(ctx context.Context, r *request) (*http.Response, error) {
+	u := *c.baseURL
+	u.Path += r.path
+	u.RawQuery = r.query.Encode()
+
+	var body io.Reader
+	if r.body != nil {
+		body = bytes.NewReader(r.body)
+	}
+	req, err := http.NewRequestWithContext(ctx, r.method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range r.header {
+		req.Header[k] = v
+	}
+	if r.contentType != "" {
+		req.Header.Set("Content-Type", r.contentType)
+	}
+	if req.Header.Get("Accept") == "" {
+		req.Header.Set("Accept", "application/json, "+problemContentType)
+	}
+	req.Header.Set("User-Agent", c.userAgent)
+	if id, ok := ctx.Value(requestIDKey{}).(string); ok && id != "" {
+		req.Header.Set(requestIDHeader, id)
+	}
+	return c.http.Do(req)
+}
+
+func (c *Client) wait(ctx context.Context, attempt int) error {
+	delay := c.retry.Backoff << (attempt - 1)
+	if c.retry.MaxBackoff > 0 && (delay > c.retry.MaxBackoff || delay <= 0) {
+		delay = c.retry.MaxBackoff
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// call выполняет запрос и декодирует JSON-ответ в out.
+func (c *Client) call(ctx context.Context, r *request, out any) error {
+	resp, err := c.do(ctx, r)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if out == nil {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+	if err = json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decode %s %s response: %w", r.method, r.path, err)
+	}
+	return nil
+}
+
+func idPath(prefix string, id uint, suffix string) string {
+	return fmt.Sprintf("%s/%d%s", prefix, id, suffix)
+}
@@ -0,0 +1,215 @@
+package client_test
+
+import (
+	"Music_Library/client"
+	"Music_Library/config"
+	"Music_Library/internal/database/postgres"
+	"Music_Library/internal/router"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/ilyakaznacheev/cleanenv"
+	"gorm.io/gorm/logger"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// testRetry — политика повторов без долгих пауз.
+var testRetry = client.RetryPolicy{MaxAttempts: 3, Backoff: time.Millisecond, MaxBackoff: 10 * time.Millisecond}
+
+// newServer запускает настоящий роутер за httptest.Server. wrap, если задан, оборачивает роутер,
+// например чтобы подменить первые ответы.
+func newServer(t *testing.T, wrap func(http.Handler) http.Handler) string {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	cfg := &config.Config{}
+	cfg.Tracing.ServiceName = "music-library-test"
+
+	var handler http.Handler = router.NewRouter(slog.New(slog.NewTextHandler(io.Discard, nil)), cfg)
+	if wrap != nil {
+		handler = wrap(handler)
+	}
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	return srv.URL
+}
+
+func newClient(t *testing.T, baseURL string, opts ...client.Option) *client.Client {
+	t.Helper()
+	c, err := client.New(baseURL, append([]client.Option{client.WithRetry(testRetry)}, opts...)...)
+	if err != nil {
+		t.Fatalf("client.New: %v", err)
+	}
+	return c
+}
+
+var (
+	dbOnce sync.Once
+	dbErr  error
+)
+
+// requireDB подключает репозиторий к базе из переменных окружения STORAGE_* и применяет миграции.
+// Без базы тест пропускается.
+func requireDB(t *testing.T) {
+	t.Helper()
+	dbOnce.Do(func() {
+		var cfg config.Config
+		if dbErr = cleanenv.ReadEnv(&cfg); dbErr != nil {
+			return
+		}
+		if cfg.Storage.Host == "" {
+			dbErr = errors.New("STORAGE_HOST is not set")
+			return
+		}
+		if dbErr = postgres.Connect(&cfg, logger.Default.LogMode(logger.Silent)); dbErr != nil {
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if dbErr = postgres.Ping(ctx); dbErr != nil {
+			return
+		}
+		dbErr = postgres.Migrate()
+	})
+	if dbErr != nil {
+		t.Skipf("database is not available: %v", dbErr)
+	}
+}
+
+// flaky отвечает статусом status на первые failures запросов, остальные передаёт роутеру.
+// calls считает все запросы.
+type flaky struct {
+	status   int
+	failures int32
+	calls    atomic.Int32
+}
+
+func (f *flaky) wrap(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if f.calls.Add(1) <= f.failures {
+			w.Header().Set("Content-Type", "application/problem+json")
+			w.WriteHeader(f.status)
+			_, _ = fmt.Fprintf(w, `{"type":"about:blank","title":%q,"status":%d}`, http.StatusText(f.status), f.status)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func TestRetryIdempotentRequests(t *testing.T) {
+	for _, status := range []int{http.StatusServiceUnavailable, http.StatusTooManyRequests} {
+		f := &flaky{status: status, failures: 2}
+		c := newClient(t, newServer(t, f.wrap))
+
+		if err := c.Alive(context.Background()); err != nil {
+			t.Fatalf("status %d: Alive: %v", status, err)
+		}
+		if got := f.calls.Load(); got != 3 {
+			t.Errorf("status %d: calls = %d, want 3", status, got)
+		}
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	f := &flaky{status: http.StatusServiceUnavailable, failures: 10}
+	c := newClient(t, newServer(t, f.wrap))
+
+	err := c.Alive(context.Background())
+	if !errors.Is(err, client.ErrServerError) {
+		t.Fatalf("error = %v, want ErrServerError", err)
+	}
+	if got := f.calls.Load(); got != int32(testRetry.MaxAttempts) {
+		t.Errorf("calls = %d, want %d", got, testRetry.MaxAttempts)
+	}
+}
+
+func TestPOSTNotRetried(t *testing.T) {
+	f := &flaky{status: http.StatusServiceUnavailable, failures: 1}
+	c := newClient(t, newServer(t, f.wrap))
+
+	_, err := c.Batch(context.Background(), client.BatchRequest{})
+	if !errors.Is(err, client.ErrServerError) {
+		t.Fatalf("error = %v, want ErrServerError", err)
+	}
+	if got := f.calls.Load(); got != 1 {
+		t.Errorf("calls = %d, want 1", got)
+	}
+}
+
+func TestPOSTRetriedWithRetryPOST(t *testing.T) {
+	f := &flaky{status: http.StatusServiceUnavailable, failures: 1}
+	policy := testRetry
+	policy.RetryPOST = true
+	c := newClient(t, newServer(t, f.wrap), client.WithRetry(policy))
+
+	// Пустой пакет отклоняет роутер, поэтому повтор доходит до обработчика без базы данных.
+	_, err := c.Batch(context.Background(), client.BatchRequest{})
+	if !errors.Is(err, client.ErrBadRequest) {
+		t.Fatalf("error = %v, want ErrBadRequest", err)
+	}
+	if got := f.calls.Load(); got != 2 {
+		t.Errorf("calls = %d, want 2", got)
+	}
+}
+
+func TestClientErrorsNotRetried(t *testing.T) {
+	f := &flaky{}
+	c := newClient(t, newServer(t, f.wrap))
+
+	_, err := c.Batch(context.Background(), client.BatchRequest{})
+	if !errors.Is(err, client.ErrBadRequest) {
+		t.Fatalf("error = %v, want ErrBadRequest", err)
+	}
+	if got := f.calls.Load(); got != 1 {
+		t.Errorf("calls = %d, want 1", got)
+	}
+}
+
+func TestAPIErrorFromProblem(t *testing.T) {
+	c := newClient(t, newServer(t, nil))
+	ctx := client.WithRequestID(context.Background(), "test-request-id")
+
+	_, err := c.Batch(ctx, client.BatchRequest{})
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("error = %v, want *APIError", err)
+	}
+	if apiErr.StatusCode != http.StatusBadRequest || apiErr.Code != "validation_failed" {
+		t.Errorf("status, code = %d, %q, want 400, validation_failed", apiErr.StatusCode, apiErr.Code)
+	}
+	if len(apiErr.Fields) != 1 || apiErr.Fields[0].Field != "operations" || apiErr.Fields[0].Code != "required" {
+		t.Errorf("fields = %+v, want operations required", apiErr.Fields)
+	}
+	if apiErr.RequestID != "test-request-id" {
+		t.Errorf("request ID = %q, want test-request-id", apiErr.RequestID)
+	}
+	for _, target := range []error{client.ErrNotFound, client.ErrConflict, client.ErrServerError} {
+		if errors.Is(err, target) {
+			t.Errorf("errors.Is(err, %v) = true", target)
+		}
+	}
+}
+
+func TestAPIErrorNotFound(t *testing.T) {
+	// Под неизвестным префиксом роутер отвечает на любой путь 404 route not found.
+	c := newClient(t, newServer(t, nil)+"/missing")
+
+	_, err := c.GetSong(context.Background(), 1)
+	if !errors.Is(err, client.ErrNotFound) {
+		t.Fatalf("error = %v, want ErrNotFound", err)
+	}
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != "not_found" || apiErr.Message != "route not found" {
+		t.Errorf("error = %+v, want not_found: route not found", apiErr)
+	}
+	if apiErr != nil && apiErr.RequestID == "" {
+		t.Error("request ID is empty, want the one generated by the server")
+	}
+}
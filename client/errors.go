@@ -0,0 +1,72 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+var (
+	ErrBadRequest  = errors.New("bad request")
+	ErrNotFound    = errors.New("not found")
+	ErrConflict    = errors.New("conflict")
+	ErrTooLarge    = errors.New("request entity too large")
+	ErrServerError = errors.New("server error")
+)
+
+// APIError — ошибка, которую вернул сервер в формате application/problem+json.
+// Её можно сравнивать с ErrNotFound и другими ошибками через errors.Is.
+// Code — машиночитаемый код ошибки, например "validation_failed", Fields — ошибки отдельных полей.
+// RequestID — идентификатор запроса из заголовка X-Request-ID, по нему ошибку можно найти в логах сервера.
+type APIError struct {
+	StatusCode int
+	Code       string
+	Message    string
+	Fields     []FieldError
+	RequestID  string
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("music library API: %d %s: %s", e.StatusCode, http.StatusText(e.StatusCode), e.Message)
+	if e.RequestID != "" {
+		msg += " (request " + e.RequestID + ")"
+	}
+	return msg
+}
+
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrBadRequest:
+		return e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrTooLarge:
+		return e.StatusCode == http.StatusRequestEntityTooLarge
+	case ErrServerError:
+		return e.StatusCode >= 500
+	}
+	return false
+}
+
+func decodeError(resp *http.Response) error {
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+
+	apiErr := &APIError{StatusCode: resp.StatusCode, RequestID: resp.Header.Get(requestIDHeader)}
+	var p problem
+	if json.Unmarshal(body, &p) == nil && p.Status != 0 {
+		apiErr.Code = p.Code
+		apiErr.Message = p.Detail
+		if apiErr.Message == "" {
+			apiErr.Message = p.Title
+		}
+		apiErr.Fields = p.Errors
+	} else {
+		apiErr.Message = string(body)
+	}
+	return apiErr
+}
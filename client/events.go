@@ -0,0 +1,107 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ErrEventsReset означает, что сервер уже не хранит часть пропущенных событий
+// и состояние нужно перечитать целиком.
+var ErrEventsReset = errors.New("event log was truncated, reload the catalogue")
+
+// EventFilter содержит параметры GET /events.
+type EventFilter struct {
+	SongIDs     []uint
+	Group       string
+	LastEventID uint64
+}
+
+// EventStream читает события из потока SSE.
+type EventStream struct {
+	body   io.ReadCloser
+	reader *bufio.Reader
+	lastID uint64
+}
+
+// Events подписывается на поток изменений. Чтобы продолжить после обрыва, передайте
+// LastEventID() старого потока в filter.LastEventID.
+func (c *Client) Events(ctx context.Context, filter EventFilter) (*EventStream, error) {
+	q := url.Values{}
+	if len(filter.SongIDs) > 0 {
+		ids := make([]string, len(filter.SongIDs))
+		for i, id := range filter.SongIDs {
+			ids[i] = strconv.FormatUint(uint64(id), 10)
+		}
+		q.Set("song_id", strings.Join(ids, ","))
+	}
+	if filter.Group != "" {
+		q.Set("group", filter.Group)
+	}
+	r := &request{method: http.MethodGet, path: "/events", query: q, header: http.Header{}}
+	r.header.Set("Accept", "text/event-stream")
+	if filter.LastEventID > 0 {
+		r.header.Set("Last-Event-ID", strconv.FormatUint(filter.LastEventID, 10))
+	}
+
+	resp, err := c.do(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	return &EventStream{body: resp.Body, reader: bufio.NewReader(resp.Body), lastID: filter.LastEventID}, nil
+}
+
+// Next блокируется до следующего события. Если журнал на сервере был усечён,
+// Next один раз вернёт ErrEventsReset и продолжит читать поток.
+func (s *EventStream) Next() (Event, error) {
+	var name, data string
+	for {
+		line, err := s.reader.ReadString('\n')
+		if err != nil {
+			return Event{}, err
+		}
+		line = strings.TrimRight(line, "\r\n")
+		if line != "" {
+			field, value, _ := strings.Cut(line, ":")
+			value = strings.TrimPrefix(value, " ")
+			switch field {
+			case "event":
+				name = value
+			case "data":
+				data += value
+			}
+			continue
+		}
+
+		switch {
+		case name == "reset":
+			return Event{}, ErrEventsReset
+		case data == "":
+			name = ""
+			continue
+		}
+		var e Event
+		if err = json.Unmarshal([]byte(data), &e); err != nil {
+			return Event{}, fmt.Errorf("decode event: %w", err)
+		}
+		s.lastID = e.ID
+		return e, nil
+	}
+}
+
+// LastEventID возвращает номер последнего прочитанного события.
+func (s *EventStream) LastEventID() uint64 {
+	return s.lastID
+}
+
+// Close закрывает поток.
+func (s *EventStream) Close() error {
+	return s.body.Close()
+}
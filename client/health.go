@@ -0,0 +1,33 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Alive проверяет, что процесс сервера работает.
+func (c *Client) Alive(ctx context.Context) error {
+	return c.call(ctx, &request{method: http.MethodGet, path: "/healthz"}, nil)
+}
+
+// Ready возвращает состояние зависимостей сервера. Ответ 503 не считается ошибкой:
+// в этом случае Status равен "not_ready", а в Checks указано, какая зависимость недоступна.
+// Запрос не повторяется.
+func (c *Client) Ready(ctx context.Context) (*Readiness, error) {
+	resp, err := c.send(ctx, &request{method: http.MethodGet, path: "/readyz"})
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusServiceUnavailable {
+		return nil, decodeError(resp)
+	}
+	defer resp.Body.Close()
+
+	var readiness Readiness
+	if err = json.NewDecoder(resp.Body).Decode(&readiness); err != nil {
+		return nil, fmt.Errorf("decode readiness: %w", err)
+	}
+	return &readiness, nil
+}
@@ -0,0 +1,142 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type lyricResponse struct {
+	Lyric Lyric `json:"lyric"`
+}
+
+// GetLyric возвращает куплет по его ID.
+func (c *Client) GetLyric(ctx context.Context, id uint) (*Lyric, error) {
+	var resp lyricResponse
+	if err := c.call(ctx, &request{method: http.MethodGet, path: idPath("/lyrics", id, "")}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Lyric, nil
+}
+
+// AddLyric добавляет куплет к песне lyric.SongID.
+func (c *Client) AddLyric(ctx context.Context, lyric Lyric) (*Lyric, error) {
+	r, err := jsonRequest(http.MethodPost, "/lyrics", lyric)
+	if err != nil {
+		return nil, err
+	}
+	var resp lyricResponse
+	if err = c.call(ctx, r, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Lyric, nil
+}
+
+// UpdateLyric заменяет куплет с указанным ID целиком.
+func (c *Client) UpdateLyric(ctx context.Context, id uint, lyric Lyric) (*Lyric, error) {
+	r, err := jsonRequest(http.MethodPut, idPath("/lyrics", id, ""), lyric)
+	if err != nil {
+		return nil, err
+	}
+	return c.lyricCall(ctx, r)
+}
+
+// PatchLyric меняет только поля куплета из patch с JSON-именами; значение nil очищает поле.
+func (c *Client) PatchLyric(ctx context.Context, id uint, patch map[string]any) (*Lyric, error) {
+	r, err := mergePatchRequest(idPath("/lyrics", id, ""), patch)
+	if err != nil {
+		return nil, err
+	}
+	return c.lyricCall(ctx, r)
+}
+
+// PatchLyricOps применяет к куплету операции JSON Patch.
+func (c *Client) PatchLyricOps(ctx context.Context, id uint, ops []PatchOp) (*Lyric, error) {
+	r, err := jsonPatchRequest(idPath("/lyrics", id, ""), ops)
+	if err != nil {
+		return nil, err
+	}
+	return c.lyricCall(ctx, r)
+}
+
+func (c *Client) lyricCall(ctx context.Context, r *request) (*Lyric, error) {
+	var resp lyricResponse
+	if err := c.call(ctx, r, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Lyric, nil
+}
+
+// DeleteLyric удаляет куплет.
+func (c *Client) DeleteLyric(ctx context.Context, id uint) error {
+	var resp deleteResponse
+	return c.call(ctx, &request{method: http.MethodDelete, path: idPath("/lyrics", id, "")}, &resp)
+}
+
+// LyricPage — одна страница куплетов песни.
+type LyricPage struct {
+	Data       []Lyric    `json:"data"`
+	Pagination Pagination `json:"pagination"`
+}
+
+// ListSongLyrics возвращает куплеты песни по порядку номеров, начиная с offset.
+func (c *Client) ListSongLyrics(ctx context.Context, songID uint, offset, pageSize int) (*LyricPage, error) {
+	q := url.Values{}
+	q.Set("offset", strconv.Itoa(offset))
+	if pageSize > 0 {
+		q.Set("page_size", strconv.Itoa(pageSize))
+	}
+	var page LyricPage
+	if err := c.call(ctx, &request{method: http.MethodGet, path: idPath("/songs", songID, "/lyrics"), query: q}, &page); err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
+
+// GetVerse возвращает куплет песни с номером verse.
+func (c *Client) GetVerse(ctx context.Context, songID uint, verse int) (*Lyric, error) {
+	r := &request{method: http.MethodGet, path: idPath("/songs", songID, fmt.Sprintf("/lyrics/%d", verse))}
+	return c.lyricCall(ctx, r)
+}
+
+// AddSongLyric добавляет куплет к песне songID; lyric.SongID можно не заполнять.
+func (c *Client) AddSongLyric(ctx context.Context, songID uint, lyric Lyric) (*Lyric, error) {
+	r, err := jsonRequest(http.MethodPost, idPath("/songs", songID, "/lyrics"), lyric)
+	if err != nil {
+		return nil, err
+	}
+	return c.lyricCall(ctx, r)
+}
+
+// InsertSongLyric вставляет куплет в песню songID под номером lyric.VerseNumber; куплеты
+// с этим и большими номерами сдвигаются на один номер.
+func (c *Client) InsertSongLyric(ctx context.Context, songID uint, lyric Lyric) (*Lyric, error) {
+	r, err := jsonRequest(http.MethodPost, idPath("/songs", songID, "/lyrics"), lyric)
+	if err != nil {
+		return nil, err
+	}
+	r.query = url.Values{"insert": {"true"}}
+	return c.lyricCall(ctx, r)
+}
+
+type lyricOrder struct {
+	IDs []uint `json:"ids"`
+}
+
+// ReorderSongLyrics нумерует куплеты песни с единицы в порядке ids и возвращает их в новом порядке.
+// В ids должны быть все куплеты песни, каждый по одному разу.
+func (c *Client) ReorderSongLyrics(ctx context.Context, songID uint, ids []uint) ([]Lyric, error) {
+	r, err := jsonRequest(http.MethodPost, idPath("/songs", songID, "/lyrics/reorder"), lyricOrder{IDs: ids})
+	if err != nil {
+		return nil, err
+	}
+	var resp struct {
+		Data []Lyric `json:"data"`
+	}
+	if err = c.call(ctx, r, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
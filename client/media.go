@@ -0,0 +1,130 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+)
+
+// multipartRequest читает файл целиком, чтобы запрос можно было повторить.
+func multipartRequest(method, path, field, filename string, file io.Reader) (*request, error) {
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	part, err := w.CreateFormFile(field, filename)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = io.Copy(part, file); err != nil {
+		return nil, fmt.Errorf("read %s: %w", filename, err)
+	}
+	if err = w.Close(); err != nil {
+		return nil, err
+	}
+	return &request{method: method, path: path, body: buf.Bytes(), contentType: w.FormDataContentType()}, nil
+}
+
+// ImportSong создаёт или обновляет песню по тегам MP3 или FLAC файла.
+func (c *Client) ImportSong(ctx context.Context, filename string, file io.Reader) (*ImportResult, error) {
+	r, err := multipartRequest(http.MethodPost, "/songs/upload", "file", filename, file)
+	if err != nil {
+		return nil, err
+	}
+	var resp ImportResult
+	if err = c.call(ctx, r, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UploadAudio прикрепляет к песне аудиофайл, заменяя предыдущий.
+func (c *Client) UploadAudio(ctx context.Context, songID uint, filename string, file io.Reader) (*AudioFile, error) {
+	r, err := multipartRequest(http.MethodPut, idPath("/songs", songID, "/audio"), "file", filename, file)
+	if err != nil {
+		return nil, err
+	}
+	var resp struct {
+		Audio AudioFile `json:"audio"`
+	}
+	if err = c.call(ctx, r, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Audio, nil
+}
+
+// Audio — поток аудиофайла песни. Body нужно закрыть после чтения.
+type Audio struct {
+	Body          io.ReadCloser
+	ContentType   string
+	ContentLength int64
+	ContentRange  string
+	ETag          string
+}
+
+// StreamAudio открывает аудиофайл песни. Если byteRange не пуст, например "bytes=0-1023",
+// сервер вернёт только указанную часть файла.
+func (c *Client) StreamAudio(ctx context.Context, songID uint, byteRange string) (*Audio, error) {
+	r := &request{method: http.MethodGet, path: idPath("/songs", songID, "/audio"), header: http.Header{}}
+	r.header.Set("Accept", "*/*")
+	if byteRange != "" {
+		r.header.Set("Range", byteRange)
+	}
+	resp, err := c.do(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	return &Audio{
+		Body:          resp.Body,
+		ContentType:   resp.Header.Get("Content-Type"),
+		ContentLength: resp.ContentLength,
+		ContentRange:  resp.Header.Get("Content-Range"),
+		ETag:          resp.Header.Get("ETag"),
+	}, nil
+}
+
+// DeleteAudio удаляет аудиофайл песни.
+func (c *Client) DeleteAudio(ctx context.Context, songID uint) error {
+	var resp deleteResponse
+	return c.call(ctx, &request{method: http.MethodDelete, path: idPath("/songs", songID, "/audio")}, &resp)
+}
+
+// UploadArtwork загружает обложку песни в формате JPEG или PNG и возвращает все её размеры.
+func (c *Client) UploadArtwork(ctx context.Context, songID uint, filename string, image io.Reader) ([]Artwork, error) {
+	r, err := multipartRequest(http.MethodPut, idPath("/songs", songID, "/artwork"), "image", filename, image)
+	if err != nil {
+		return nil, err
+	}
+	var resp struct {
+		Artwork []Artwork `json:"artwork"`
+	}
+	if err = c.call(ctx, r, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Artwork, nil
+}
+
+// DeleteArtwork удаляет обложку песни.
+func (c *Client) DeleteArtwork(ctx context.Context, songID uint) error {
+	var resp deleteResponse
+	return c.call(ctx, &request{method: http.MethodDelete, path: idPath("/songs", songID, "/artwork")}, &resp)
+}
+
+// GetArtwork открывает изображение по ключу или по URL из Artwork.URL. Body нужно закрыть после чтения.
+func (c *Client) GetArtwork(ctx context.Context, keyOrURL string) (io.ReadCloser, string, error) {
+	path := keyOrURL
+	if u, err := url.Parse(keyOrURL); err == nil && u.Path != "" && u.Path[0] == '/' {
+		path = u.Path
+	} else {
+		path = "/artwork/" + url.PathEscape(keyOrURL)
+	}
+	r := &request{method: http.MethodGet, path: path, header: http.Header{}}
+	r.header.Set("Accept", "image/*")
+	resp, err := c.do(ctx, r)
+	if err != nil {
+		return nil, "", err
+	}
+	return resp.Body, resp.Header.Get("Content-Type"), nil
+}
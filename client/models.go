@@ -0,0 +1,171 @@
+package client
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Типы ниже повторяют JSON, который отдаёт и принимает API. Они не зависят от внутренних
+// моделей сервера, поэтому клиент не тянет за собой gin, GORM и валидатор.
+
+// Song — песня. ReleaseDate передаётся строкой "1997", "1997-07" или "1997-07-16";
+// пустая строка означает, что дата неизвестна.
+type Song struct {
+	ID          uint      `json:"ID"`
+	Group       string    `json:"group"`
+	Title       string    `json:"title"`
+	ReleaseDate string    `json:"release_date"`
+	Link        string    `json:"link"`
+	Lyrics      []Lyric   `json:"lyrics"`
+	Artwork     []Artwork `json:"artwork,omitempty"`
+}
+
+// Lyric — куплет песни.
+type Lyric struct {
+	ID          uint   `json:"ID"`
+	SongID      uint   `json:"song_id"`
+	VerseNumber int    `json:"verse_number"`
+	Text        string `json:"text"`
+}
+
+// Artwork — один размер обложки песни. URL указан относительно адреса API.
+type Artwork struct {
+	Size        string `json:"size"`
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+	ContentType string `json:"content_type"`
+	URL         string `json:"url"`
+}
+
+// AudioFile — описание аудиофайла, прикреплённого к песне.
+type AudioFile struct {
+	ID          uint   `json:"ID"`
+	SongID      uint   `json:"song_id"`
+	Checksum    string `json:"checksum"`
+	Size        int64  `json:"size"`
+	ContentType string `json:"content_type"`
+	Filename    string `json:"filename"`
+}
+
+// ImportResult — песня, созданная или обновлённая из тегов аудиофайла, и поля, взятые из файла.
+type ImportResult struct {
+	Song           *Song    `json:"song"`
+	Created        bool     `json:"created"`
+	FieldsFromFile []string `json:"fields_from_file"`
+}
+
+// Webhook — подписка на изменения каталога. При создании сервер читает только URL, Events и Secret.
+type Webhook struct {
+	ID        uint      `json:"ID"`
+	URL       string    `json:"url"`
+	Events    []string  `json:"events"`
+	Secret    string    `json:"secret,omitempty"`
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Статусы доставки вебхука.
+const (
+	DeliveryPending   = "pending"
+	DeliveryDelivered = "delivered"
+	DeliveryFailed    = "failed"
+)
+
+// WebhookDelivery — запись журнала доставок вебхука.
+type WebhookDelivery struct {
+	ID             uint       `json:"ID"`
+	WebhookID      uint       `json:"webhook_id"`
+	Event          string     `json:"event"`
+	Payload        string     `json:"payload"`
+	Status         string     `json:"status"`
+	Attempts       int        `json:"attempts"`
+	NextAttemptAt  time.Time  `json:"next_attempt_at"`
+	ResponseStatus int        `json:"response_status"`
+	LastError      string     `json:"last_error"`
+	CreatedAt      time.Time  `json:"created_at"`
+	DeliveredAt    *time.Time `json:"delivered_at"`
+}
+
+// FieldError объясняет, почему сервер отклонил значение поля.
+type FieldError struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// BatchRequest — операции над песнями и куплетами для POST /batch.
+// С Atomic все операции выполняются в одной транзакции.
+type BatchRequest struct {
+	Atomic     bool             `json:"atomic"`
+	Operations []BatchOperation `json:"operations"`
+}
+
+// BatchOperation — одна операция пакета: Op — get, create, update, patch или delete,
+// Resource — song или lyric. Data — тело операции, для patch — JSON Merge Patch.
+type BatchOperation struct {
+	Op       string          `json:"op"`
+	Resource string          `json:"resource"`
+	ID       uint            `json:"id,omitempty"`
+	Data     json.RawMessage `json:"data,omitempty"`
+}
+
+// BatchResponse — результаты пакета в порядке операций. Committed равен false, если атомарный пакет откатился.
+type BatchResponse struct {
+	Committed bool          `json:"committed"`
+	Results   []BatchResult `json:"results"`
+}
+
+// BatchResult — результат одной операции. Data можно декодировать в Song или Lyric.
+type BatchResult struct {
+	Status int             `json:"status"`
+	Data   json.RawMessage `json:"data,omitempty"`
+	Error  *BatchError     `json:"error,omitempty"`
+}
+
+// BatchError — ошибка одной операции пакета.
+type BatchError struct {
+	Code   string       `json:"code"`
+	Detail string       `json:"detail"`
+	Errors []FieldError `json:"errors,omitempty"`
+}
+
+// Readiness — состояние зависимостей сервера: Status равен "ready" или "not_ready".
+type Readiness struct {
+	Status string                 `json:"status"`
+	Checks map[string]CheckResult `json:"checks"`
+}
+
+// CheckResult — состояние одной зависимости.
+type CheckResult struct {
+	Status    string         `json:"status"`
+	LatencyMS int64          `json:"latency_ms"`
+	Error     string         `json:"error,omitempty"`
+	Details   map[string]any `json:"details,omitempty"`
+}
+
+// Event — изменение каталога из потока GET /events. Data — песня или куплет после изменения;
+// у событий удаления его нет.
+type Event struct {
+	ID      uint64          `json:"id"`
+	Type    string          `json:"type"`
+	Time    time.Time       `json:"time"`
+	SongID  uint            `json:"song_id"`
+	LyricID uint            `json:"lyric_id,omitempty"`
+	Group   string          `json:"group,omitempty"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+// problem — тело ответа об ошибке в формате application/problem+json.
+type problem struct {
+	Title  string       `json:"title"`
+	Status int          `json:"status"`
+	Detail string       `json:"detail"`
+	Code   string       `json:"code"`
+	Errors []FieldError `json:"errors"`
+}
+
+// deleteResponse — ответ API на удаление.
+type deleteResponse struct {
+	ID      int    `json:"id"`
+	Message string `json:"message"`
+}
@@ -0,0 +1,173 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// SongFilter содержит фильтры GET /songs. Пустые поля не передаются.
+type SongFilter struct {
+	// IDs отбирает не больше 100 песен с перечисленными ID.
+	IDs         []uint
+	Group       string
+	Title       string
+	ReleaseDate string
+	Link        string
+	// ReleasedAfter и ReleasedBefore — границы даты выхода включительно: YYYY-MM-DD, YYYY-MM или YYYY.
+	ReleasedAfter  string
+	ReleasedBefore string
+	Year           int
+	// Sort — порядок страниц, например "-release_date,title".
+	Sort string
+	// Fields ограничивает поля песен, например "title,release_date"; Include загружает связи
+	// ("lyrics", "artwork"), без него куплеты в списке не возвращаются.
+	Fields  string
+	Include string
+}
+
+func (f SongFilter) values() url.Values {
+	q := url.Values{}
+	set := func(key, value string) {
+		if value != "" {
+			q.Set(key, value)
+		}
+	}
+	if len(f.IDs) > 0 {
+		ids := make([]string, len(f.IDs))
+		for i, id := range f.IDs {
+			ids[i] = strconv.FormatUint(uint64(id), 10)
+		}
+		q.Set("ids", strings.Join(ids, ","))
+	}
+	set("group", f.Group)
+	set("title", f.Title)
+	set("release_date", f.ReleaseDate)
+	set("link", f.Link)
+	set("released_after", f.ReleasedAfter)
+	set("released_before", f.ReleasedBefore)
+	set("sort", f.Sort)
+	set("fields", f.Fields)
+	set("include", f.Include)
+	if f.Year != 0 {
+		q.Set("year", strconv.Itoa(f.Year))
+	}
+	return q
+}
+
+// SongPage — одна страница GET /songs.
+type SongPage struct {
+	Data       []Song     `json:"data"`
+	Pagination Pagination `json:"pagination"`
+}
+
+// ListSongs возвращает одну страницу песен, начиная с offset.
+func (c *Client) ListSongs(ctx context.Context, filter SongFilter, offset, pageSize int) (*SongPage, error) {
+	q := filter.values()
+	q.Set("offset", strconv.Itoa(offset))
+	if pageSize > 0 {
+		q.Set("page_size", strconv.Itoa(pageSize))
+	}
+
+	var page SongPage
+	if err := c.call(ctx, &request{method: http.MethodGet, path: "/songs", query: q}, &page); err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
+
+// Songs перебирает все песни, подходящие под фильтр, запрашивая страницы размером pageSize по мере надобности.
+// Перебор останавливается на первой ошибке, которая возвращается вторым значением.
+func (c *Client) Songs(ctx context.Context, filter SongFilter, pageSize int) iter.Seq2[Song, error] {
+	return func(yield func(Song, error) bool) {
+		offset := 0
+		for {
+			page, err := c.ListSongs(ctx, filter, offset, pageSize)
+			if err != nil {
+				yield(Song{}, err)
+				return
+			}
+			for _, song := range page.Data {
+				if !yield(song, nil) {
+					return
+				}
+			}
+			offset += len(page.Data)
+			if len(page.Data) == 0 || int64(offset) >= page.Pagination.Total {
+				return
+			}
+		}
+	}
+}
+
+type songResponse struct {
+	Song Song `json:"song"`
+}
+
+// GetSong возвращает песню с текстом и обложками.
+func (c *Client) GetSong(ctx context.Context, id uint) (*Song, error) {
+	var resp songResponse
+	if err := c.call(ctx, &request{method: http.MethodGet, path: idPath("/songs", id, "")}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Song, nil
+}
+
+// AddSong создаёт песню. Недостающие поля сервер может дополнить из внешнего сервиса.
+func (c *Client) AddSong(ctx context.Context, song Song) (*Song, error) {
+	r, err := jsonRequest(http.MethodPost, "/songs", song)
+	if err != nil {
+		return nil, err
+	}
+	var resp songResponse
+	if err = c.call(ctx, r, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Song, nil
+}
+
+// UpdateSong заменяет песню с указанным ID целиком: незаполненные поля очищаются,
+// а куплеты без ID добавляются вместо отсутствующих в song.
+func (c *Client) UpdateSong(ctx context.Context, id uint, song Song) (*Song, error) {
+	r, err := jsonRequest(http.MethodPut, idPath("/songs", id, ""), song)
+	if err != nil {
+		return nil, err
+	}
+	return c.songCall(ctx, r)
+}
+
+// PatchSong меняет только поля песни из patch с JSON-именами ("link", "release_date" и т. д.);
+// значение nil очищает поле.
+func (c *Client) PatchSong(ctx context.Context, id uint, patch map[string]any) (*Song, error) {
+	r, err := mergePatchRequest(idPath("/songs", id, ""), patch)
+	if err != nil {
+		return nil, err
+	}
+	return c.songCall(ctx, r)
+}
+
+// PatchSongOps применяет к песне операции JSON Patch.
+func (c *Client) PatchSongOps(ctx context.Context, id uint, ops []PatchOp) (*Song, error) {
+	r, err := jsonPatchRequest(idPath("/songs", id, ""), ops)
+	if err != nil {
+		return nil, err
+	}
+	return c.songCall(ctx, r)
+}
+
+func (c *Client) songCall(ctx context.Context, r *request) (*Song, error) {
+	var resp songResponse
+	if err := c.call(ctx, r, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Song, nil
+}
+
+// DeleteSong удаляет песню вместе с текстом, аудиофайлом и обложками.
+func (c *Client) DeleteSong(ctx context.Context, id uint) error {
+	var resp deleteResponse
+	return c.call(ctx, &request{method: http.MethodDelete, path: idPath("/songs", id, "")}, &resp)
+}
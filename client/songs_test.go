@@ -0,0 +1,122 @@
+package client_test
+
+import (
+	"Music_Library/client"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+// uniqueGroup возвращает название группы, которого нет в других тестах и прошлых запусках.
+func uniqueGroup(t *testing.T) string {
+	return fmt.Sprintf("%s %d", t.Name(), time.Now().UnixNano())
+}
+
+func TestSongCRUD(t *testing.T) {
+	requireDB(t)
+	c := newClient(t, newServer(t, nil))
+	ctx := context.Background()
+
+	song, err := c.AddSong(ctx, client.Song{Group: uniqueGroup(t), Title: "Uprising",
+		Lyrics: []client.Lyric{{VerseNumber: 1, Text: "Paranoia is in bloom"}}})
+	if err != nil {
+		t.Fatalf("AddSong: %v", err)
+	}
+	t.Cleanup(func() { _ = c.DeleteSong(context.Background(), song.ID) })
+	if song.ID == 0 {
+		t.Fatal("AddSong returned a song without ID")
+	}
+
+	got, err := c.GetSong(ctx, song.ID)
+	if err != nil {
+		t.Fatalf("GetSong: %v", err)
+	}
+	if got.Title != "Uprising" || len(got.Lyrics) != 1 || got.Lyrics[0].Text != "Paranoia is in bloom" {
+		t.Errorf("GetSong = %+v, want the added song with one verse", got)
+	}
+
+	song.Title = "Resistance"
+	song.Lyrics = append(got.Lyrics, client.Lyric{VerseNumber: 2, Text: "The PR transmissions will resume"})
+	updated, err := c.UpdateSong(ctx, song.ID, *song)
+	if err != nil {
+		t.Fatalf("UpdateSong: %v", err)
+	}
+	if updated.Title != "Resistance" || len(updated.Lyrics) != 2 {
+		t.Errorf("UpdateSong = %+v, want title Resistance and two verses", updated)
+	}
+
+	patched, err := c.PatchSong(ctx, song.ID, map[string]any{"link": "https://example.com/resistance"})
+	if err != nil {
+		t.Fatalf("PatchSong: %v", err)
+	}
+	if patched.Link != "https://example.com/resistance" || patched.Title != "Resistance" {
+		t.Errorf("PatchSong = %+v, want new link and the same title", patched)
+	}
+
+	_, err = c.AddSongLyric(ctx, song.ID, client.Lyric{VerseNumber: 2, Text: "taken"})
+	if !errors.Is(err, client.ErrConflict) {
+		t.Errorf("AddSongLyric with a taken verse number: error = %v, want ErrConflict", err)
+	}
+
+	if err = c.DeleteSong(ctx, song.ID); err != nil {
+		t.Fatalf("DeleteSong: %v", err)
+	}
+	if _, err = c.GetSong(ctx, song.ID); !errors.Is(err, client.ErrNotFound) {
+		t.Errorf("GetSong after delete: error = %v, want ErrNotFound", err)
+	}
+	if err = c.DeleteSong(ctx, song.ID); !errors.Is(err, client.ErrNotFound) {
+		t.Errorf("DeleteSong twice: error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestSongsIterator(t *testing.T) {
+	requireDB(t)
+	c := newClient(t, newServer(t, nil))
+	ctx := context.Background()
+
+	group := uniqueGroup(t)
+	var ids []uint
+	for i := 1; i <= 5; i++ {
+		song, err := c.AddSong(ctx, client.Song{Group: group, Title: fmt.Sprintf("Song %d", i)})
+		if err != nil {
+			t.Fatalf("AddSong %d: %v", i, err)
+		}
+		ids = append(ids, song.ID)
+		t.Cleanup(func() { _ = c.DeleteSong(context.Background(), song.ID) })
+	}
+
+	page, err := c.ListSongs(ctx, client.SongFilter{Group: group}, 0, 2)
+	if err != nil {
+		t.Fatalf("ListSongs: %v", err)
+	}
+	if len(page.Data) != 2 || page.Pagination.Total != 5 {
+		t.Errorf("first page: %d songs of %d, want 2 of 5", len(page.Data), page.Pagination.Total)
+	}
+
+	var got []uint
+	for song, err := range c.Songs(ctx, client.SongFilter{Group: group, Sort: "id"}, 2) {
+		if err != nil {
+			t.Fatalf("Songs: %v", err)
+		}
+		got = append(got, song.ID)
+	}
+	if fmt.Sprint(got) != fmt.Sprint(ids) {
+		t.Errorf("Songs = %v, want %v", got, ids)
+	}
+
+	// Перебор можно прервать, не дочитывая страницы.
+	n := 0
+	for _, err := range c.Songs(ctx, client.SongFilter{Group: group}, 2) {
+		if err != nil {
+			t.Fatalf("Songs: %v", err)
+		}
+		if n++; n == 3 {
+			break
+		}
+	}
+	if n != 3 {
+		t.Errorf("stopped after %d songs, want 3", n)
+	}
+}
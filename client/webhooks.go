@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type webhookResponse struct {
+	Webhook Webhook `json:"webhook"`
+}
+
+// AddWebhook регистрирует подписку. Если секрет не задан, сервер сгенерирует его и вернёт в ответе.
+func (c *Client) AddWebhook(ctx context.Context, webhook Webhook) (*Webhook, error) {
+	r, err := jsonRequest(http.MethodPost, "/webhooks", webhook)
+	if err != nil {
+		return nil, err
+	}
+	var resp webhookResponse
+	if err = c.call(ctx, r, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Webhook, nil
+}
+
+// ListWebhooks возвращает все подписки.
+func (c *Client) ListWebhooks(ctx context.Context) ([]Webhook, error) {
+	var resp struct {
+		Data []Webhook `json:"data"`
+	}
+	if err := c.call(ctx, &request{method: http.MethodGet, path: "/webhooks"}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+// GetWebhook возвращает подписку по ID.
+func (c *Client) GetWebhook(ctx context.Context, id uint) (*Webhook, error) {
+	var resp webhookResponse
+	if err := c.call(ctx, &request{method: http.MethodGet, path: idPath("/webhooks", id, "")}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Webhook, nil
+}
+
+// DeleteWebhook удаляет подписку вместе с журналом доставок.
+func (c *Client) DeleteWebhook(ctx context.Context, id uint) error {
+	var resp deleteResponse
+	return c.call(ctx, &request{method: http.MethodDelete, path: idPath("/webhooks", id, "")}, &resp)
+}
+
+// DeliveryPage — одна страница журнала доставок.
+type DeliveryPage struct {
+	Data       []WebhookDelivery `json:"data"`
+	Pagination Pagination        `json:"pagination"`
+}
+
+// ListWebhookDeliveries возвращает страницу журнала доставок подписки, начиная с последних.
+func (c *Client) ListWebhookDeliveries(ctx context.Context, id uint, offset, pageSize int) (*DeliveryPage, error) {
+	q := url.Values{}
+	q.Set("offset", strconv.Itoa(offset))
+	if pageSize > 0 {
+		q.Set("page_size", strconv.Itoa(pageSize))
+	}
+	var page DeliveryPage
+	if err := c.call(ctx, &request{method: http.MethodGet, path: idPath("/webhooks", id, "/deliveries"), query: q}, &page); err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
+
+// RedeliverWebhook ставит доставку в очередь повторно.
+func (c *Client) RedeliverWebhook(ctx context.Context, id, deliveryID uint) (*WebhookDelivery, error) {
+	path := idPath("/webhooks", id, fmt.Sprintf("/deliveries/%d/redeliver", deliveryID))
+	var resp struct {
+		Delivery WebhookDelivery `json:"delivery"`
+	}
+	if err := c.call(ctx, &request{method: http.MethodPost, path: path}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Delivery, nil
+}
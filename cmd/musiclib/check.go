@@ -0,0 +1,99 @@
+package main
+
+import (
+	"Music_Library/internal/database/postgres"
+	"Music_Library/internal/storage"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+)
+
+var errProblemsFound = errors.New("integrity problems found")
+
+func migrate(e *env, args []string) error {
+	fs, format := newFlags("migrate", "", "table")
+	if err := e.parse(fs, format, args, 0, 0); err != nil {
+		return err
+	}
+	if err := postgres.Migrate(); err != nil {
+		return err
+	}
+	if *format == "json" {
+		return printJSON(os.Stdout, map[string]int{"version": postgres.LatestVersion()})
+	}
+	fmt.Printf("database schema is at version %d\n", postgres.LatestVersion())
+	return nil
+}
+
+// check ищет висячие ссылки, повторяющиеся куплеты и файлы, пропавшие из хранилища.
+// Если проблемы найдены, команда завершается с ошибкой, чтобы её можно было запускать из cron.
+func check(e *env, args []string) error {
+	fs, format := newFlags("check", "", "table")
+	skipBlobs := fs.Bool("skip-blobs", false, "do not check that audio and artwork files exist in blob storage")
+	if err := e.parse(fs, format, args, 0, 0); err != nil {
+		return err
+	}
+
+	problems, err := postgres.CheckIntegrity(e.ctx)
+	if err != nil {
+		return err
+	}
+	if !*skipBlobs {
+		missing, err := checkBlobs(e)
+		if err != nil {
+			return err
+		}
+		problems = append(problems, missing...)
+	}
+
+	if *format == "json" {
+		if problems == nil {
+			problems = []postgres.Problem{}
+		}
+		if err = printJSON(os.Stdout, problems); err != nil {
+			return err
+		}
+	} else if len(problems) == 0 {
+		fmt.Println("no problems found")
+	} else {
+		rows := make([][]string, len(problems))
+		for i, p := range problems {
+			rows[i] = []string{p.Kind, p.Table, strconv.Itoa(int(p.ID)), p.Detail}
+		}
+		if err = printTable(os.Stdout, []string{"PROBLEM", "TABLE", "ID", "DETAIL"}, rows); err != nil {
+			return err
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: %d", errProblemsFound, len(problems))
+	}
+	return nil
+}
+
+func checkBlobs(e *env) ([]postgres.Problem, error) {
+	if err := storage.SetupStorage(e.log, e.cfg); err != nil {
+		return nil, err
+	}
+	refs, err := postgres.GetBlobRefs(e.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var problems []postgres.Problem
+	for _, ref := range refs {
+		exists, err := storage.Blobs.Exists(ref.Key)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			problems = append(problems, postgres.Problem{
+				Kind:   "missing_blob",
+				Table:  ref.Table,
+				ID:     ref.ID,
+				Detail: "file " + ref.Key + " is missing from blob storage",
+			})
+		}
+	}
+	return problems, nil
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"Music_Library/internal/database/postgres"
+	"Music_Library/internal/models"
+	"fmt"
+	"os"
+)
+
+func addLyric(e *env, args []string) error {
+	fs, format := newFlags("lyrics add", "", "table")
+	songID := fs.Uint("song", 0, "song ID (required)")
+	verse := fs.Int("verse", 0, "verse number (required)")
+	text := fs.String("text", "", `verse text, "-" reads standard input (required)`)
+	insert := fs.Bool("insert", false, "if the verse number is taken, move it and later verses one number down")
+	if err := e.parse(fs, format, args, 0, 0); err != nil {
+		return err
+	}
+	if *songID == 0 || *verse == 0 || *text == "" {
+		fmt.Fprintln(fs.Output(), "-song, -verse and -text are required")
+		return errUsage
+	}
+
+	body, err := readText(*text)
+	if err != nil {
+		return err
+	}
+	if _, err = postgres.GetSong(e.ctx, *songID); err != nil {
+		return fmt.Errorf("song %d: %w", *songID, err)
+	}
+	lyric := &models.Lyric{SongID: *songID, VerseNumber: *verse, Text: body}
+	if *insert {
+		err = postgres.InsertLyric(e.ctx, lyric)
+	} else {
+		err = postgres.AddLyric(e.ctx, lyric)
+	}
+	if err != nil {
+		return err
+	}
+	if *format == "json" {
+		return printJSON(os.Stdout, lyric)
+	}
+	fmt.Printf("added verse %d\n", lyric.ID)
+	return nil
+}
+
+func editLyric(e *env, args []string) error {
+	fs, format := newFlags("lyrics edit", "ID", "table")
+	verse := fs.Int("verse", 0, "new verse number")
+	text := fs.String("text", "", `new verse text, "-" reads standard input`)
+	if err := e.parse(fs, format, args, 1, 1); err != nil {
+		return err
+	}
+	id, err := parseID(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	if *verse == 0 && *text == "" {
+		fmt.Fprintln(fs.Output(), "nothing to change, set -verse or -text")
+		return errUsage
+	}
+
+	body, err := readText(*text)
+	if err != nil {
+		return err
+	}
+	lyric, err := postgres.UpdateLyric(e.ctx, id, &models.Lyric{VerseNumber: *verse, Text: body})
+	if err != nil {
+		return err
+	}
+	if *format == "json" {
+		return printJSON(os.Stdout, lyric)
+	}
+	fmt.Printf("updated verse %d\n", lyric.ID)
+	return nil
+}
+
+func reorderLyrics(e *env, args []string) error {
+	fs, format := newFlags("lyrics reorder", "ID...", "table")
+	songID := fs.Uint("song", 0, "song ID (required)")
+	if err := e.parse(fs, format, args, 1, -1); err != nil {
+		return err
+	}
+	if *songID == 0 {
+		fmt.Fprintln(fs.Output(), "-song is required")
+		return errUsage
+	}
+	ids := make([]uint, fs.NArg())
+	for i, arg := range fs.Args() {
+		id, err := parseID(arg)
+		if err != nil {
+			return err
+		}
+		ids[i] = id
+	}
+
+	lyrics, err := postgres.ReorderLyrics(e.ctx, *songID, ids)
+	if err != nil {
+		return err
+	}
+	if *format == "json" {
+		return printJSON(os.Stdout, lyrics)
+	}
+	for _, lyric := range lyrics {
+		fmt.Printf("verse %d is number %d\n", lyric.ID, lyric.VerseNumber)
+	}
+	return nil
+}
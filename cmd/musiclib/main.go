@@ -0,0 +1,189 @@
+// Команда musiclib — инструмент администратора музыкальной библиотеки.
+// Работает с базой данных напрямую через слой репозитория и читает тот же config.yaml, что и сервер.
+package main
+
+import (
+	"Music_Library/config"
+	"Music_Library/internal/database/postgres"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"gorm.io/gorm/logger"
+	"io"
+	"log/slog"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+)
+
+const usage = `Usage: musiclib [-config path] <command> [flags] [arguments]
+
+Commands:
+  songs list       list songs with filters
+  songs get        show a song with its lyrics
+  songs add        add a song
+  songs rm         delete songs
+  lyrics add       add a verse to a song
+  lyrics edit      change a verse
+  lyrics reorder   renumber the verses of a song in the given order
+  import           import songs from MP3/FLAC files or from a JSON export
+  export           export songs with lyrics as JSON
+  migrate          create or update database tables
+  check            look for integrity problems
+
+The config file is taken from -config, $CONFIG_PATH or config/config.yaml.
+Run "musiclib <command> -h" for command flags.
+`
+
+var errUsage = errors.New("invalid usage")
+
+type command func(e *env, args []string) error
+
+// env хранит общие для команд настройки. Соединение с базой открывается после разбора флагов,
+// чтобы справка работала и без базы данных.
+type env struct {
+	ctx context.Context
+	log *slog.Logger
+	cfg *config.Config
+}
+
+var commands = map[string]command{
+	"songs list":     listSongs,
+	"songs get":      getSong,
+	"songs add":      addSong,
+	"songs rm":       removeSongs,
+	"lyrics add":     addLyric,
+	"lyrics edit":    editLyric,
+	"lyrics reorder": reorderLyrics,
+	"import":         importSongs,
+	"export":         exportSongs,
+	"migrate":        migrate,
+	"check":          check,
+}
+
+func main() {
+	os.Exit(run(os.Args[1:]))
+}
+
+func run(args []string) int {
+	global := flag.NewFlagSet("musiclib", flag.ContinueOnError)
+	global.Usage = func() { fmt.Fprint(os.Stderr, usage) }
+	configPath := global.String("config", "", "path to the config file")
+	if err := global.Parse(args); err != nil {
+		return 2
+	}
+	args = global.Args()
+	if len(args) == 0 || args[0] == "help" {
+		fmt.Fprint(os.Stderr, usage)
+		return 2
+	}
+
+	name, rest := args[0], args[1:]
+	if name == "songs" || name == "lyrics" {
+		if len(rest) == 0 {
+			fmt.Fprint(os.Stderr, usage)
+			return 2
+		}
+		name, rest = name+" "+rest[0], rest[1:]
+	}
+	cmd, ok := commands[name]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n%s", name, usage)
+		return 2
+	}
+
+	cfg, err := config.Load(config.Path(*configPath))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "musiclib:", err)
+		return 1
+	}
+	// Ctrl+C прерывает текущий запрос к базе, а не только процесс.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	e := &env{
+		ctx: ctx,
+		log: slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelWarn})),
+		cfg: cfg,
+	}
+	if err = cmd(e, rest); err != nil {
+		if errors.Is(err, errUsage) || errors.Is(err, flag.ErrHelp) {
+			return 2
+		}
+		fmt.Fprintln(os.Stderr, "musiclib:", err)
+		return 1
+	}
+	return 0
+}
+
+// newFlags создаёт набор флагов команды с общим флагом -o.
+func newFlags(name, args, defaultFormat string) (*flag.FlagSet, *string) {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s\n\nFlags:\n", strings.TrimSpace("musiclib "+name+" [flags] "+args))
+		fs.PrintDefaults()
+	}
+	format := fs.String("o", defaultFormat, "output format: table or json")
+	return fs, format
+}
+
+// parse разбирает флаги, проверяет число позиционных аргументов и подключается к базе данных.
+func (e *env) parse(fs *flag.FlagSet, format *string, args []string, minArgs, maxArgs int) error {
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *format != "table" && *format != "json" {
+		fmt.Fprintf(fs.Output(), "unknown output format %q\n", *format)
+		return errUsage
+	}
+	if n := fs.NArg(); n < minArgs || (maxArgs >= 0 && n > maxArgs) {
+		fs.Usage()
+		return errUsage
+	}
+
+	if err := postgres.Connect(e.cfg, logger.Default.LogMode(logger.Silent)); err != nil {
+		return fmt.Errorf("connect to database: %w", err)
+	}
+	return nil
+}
+
+func parseID(s string) (uint, error) {
+	id, err := strconv.ParseUint(s, 10, 0)
+	if err != nil || id == 0 {
+		return 0, fmt.Errorf("invalid ID %q", s)
+	}
+	return uint(id), nil
+}
+
+// printJSON выводит значение с отступами.
+func printJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// printTable выводит строки, выравнивая столбцы.
+func printTable(w io.Writer, header []string, rows [][]string) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(header, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
+
+// readText возвращает значение флага или, если оно равно "-", стандартный ввод.
+func readText(value string) (string, error) {
+	if value != "-" {
+		return value, nil
+	}
+	data, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"Music_Library/internal/database/postgres"
+	"Music_Library/internal/logging"
+	"Music_Library/internal/models"
+	"Music_Library/internal/storage"
+	"Music_Library/internal/tags"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+)
+
+func listSongs(e *env, args []string) error {
+	fs, format := newFlags("songs list", "", "table")
+	group := fs.String("group", "", "filter by group")
+	title := fs.String("title", "", "filter by title")
+	releaseDate := fs.String("release-date", "", "filter by release date")
+	releasedAfter := fs.String("released-after", "", "songs released on or after the date")
+	releasedBefore := fs.String("released-before", "", "songs released on or before the date")
+	year := fs.Int("year", 0, "songs released in the year")
+	link := fs.String("link", "", "filter by link")
+	sort := fs.String("sort", "", `sort fields, e.g. "-release_date,title"`)
+	offset := fs.Int("offset", 0, "number of songs to skip")
+	limit := fs.Int("limit", 50, "maximum number of songs")
+	if err := e.parse(fs, format, args, 0, 0); err != nil {
+		return err
+	}
+
+	filter := postgres.SongFilter{
+		Group:          *group,
+		Title:          *title,
+		Link:           *link,
+		ReleaseDate:    models.NewReleaseDate(*releaseDate),
+		ReleasedAfter:  models.NewReleaseDate(*releasedAfter),
+		ReleasedBefore: models.NewReleaseDate(*releasedBefore),
+	}
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == "year" {
+			filter.Year = year
+		}
+	})
+	order, err := postgres.ParseSongSort(*sort)
+	if err != nil {
+		return err
+	}
+	songs, total, err := postgres.GetAllSongs(e.ctx, filter, order, postgres.SongFields{Lyrics: true}, *offset, *limit)
+	if err != nil {
+		return err
+	}
+	if *format == "json" {
+		return printJSON(os.Stdout, map[string]any{"data": songs, "total": total})
+	}
+
+	rows := make([][]string, len(songs))
+	for i, s := range songs {
+		rows[i] = []string{strconv.Itoa(int(s.ID)), s.Group, s.Title, s.ReleaseDate.String(), strconv.Itoa(len(s.Lyrics)), s.Link}
+	}
+	if err = printTable(os.Stdout, []string{"ID", "GROUP", "TITLE", "RELEASED", "VERSES", "LINK"}, rows); err != nil {
+		return err
+	}
+	fmt.Printf("\n%d of %d songs\n", len(songs), total)
+	return nil
+}
+
+func getSong(e *env, args []string) error {
+	fs, format := newFlags("songs get", "ID", "table")
+	if err := e.parse(fs, format, args, 1, 1); err != nil {
+		return err
+	}
+	id, err := parseID(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	song, err := postgres.GetSong(e.ctx, id)
+	if err != nil {
+		return err
+	}
+	if *format == "json" {
+		return printJSON(os.Stdout, song)
+	}
+	printSong(song)
+	return nil
+}
+
+func printSong(song *models.Song) {
+	fmt.Printf("ID:        %d\nGroup:     %s\nTitle:     %s\nReleased:  %s\nLink:      %s\n",
+		song.ID, song.Group, song.Title, song.ReleaseDate, song.Link)
+	for _, lyric := range song.Lyrics {
+		fmt.Printf("\n[%d] verse %d\n%s\n", lyric.ID, lyric.VerseNumber, lyric.Text)
+	}
+}
+
+func addSong(e *env, args []string) error {
+	fs, format := newFlags("songs add", "", "table")
+	group := fs.String("group", "", "group name (required)")
+	title := fs.String("title", "", "song title (required)")
+	releaseDate := fs.String("release-date", "", "release date: YYYY-MM-DD, YYYY-MM or YYYY")
+	link := fs.String("link", "", "link to the song")
+	lyrics := fs.String("lyrics", "", `lyrics with verses separated by blank lines, "-" reads standard input`)
+	if err := e.parse(fs, format, args, 0, 0); err != nil {
+		return err
+	}
+	if *group == "" || *title == "" {
+		fmt.Fprintln(fs.Output(), "-group and -title are required")
+		return errUsage
+	}
+
+	song := &models.Song{Group: *group, Title: *title, ReleaseDate: models.NewReleaseDate(*releaseDate), Link: *link}
+	text, err := readText(*lyrics)
+	if err != nil {
+		return err
+	}
+	for i, verse := range tags.SplitVerses(text) {
+		song.Lyrics = append(song.Lyrics, models.Lyric{VerseNumber: i + 1, Text: verse})
+	}
+	if err = postgres.AddSong(e.ctx, song); err != nil {
+		return err
+	}
+	if *format == "json" {
+		return printJSON(os.Stdout, song)
+	}
+	fmt.Printf("added song %d\n", song.ID)
+	return nil
+}
+
+func removeSongs(e *env, args []string) error {
+	fs, format := newFlags("songs rm", "ID...", "table")
+	if err := e.parse(fs, format, args, 1, -1); err != nil {
+		return err
+	}
+	ids := make([]uint, fs.NArg())
+	for i, arg := range fs.Args() {
+		id, err := parseID(arg)
+		if err != nil {
+			return err
+		}
+		ids[i] = id
+	}
+	// Файлы удалённых песен освобождаются в хранилище так же, как при удалении через API.
+	if err := storage.SetupStorage(e.log, e.cfg); err != nil {
+		return err
+	}
+	ctx := logging.WithLogger(e.ctx, e.log)
+
+	var removed []uint
+	for _, id := range ids {
+		if err := postgres.DeleteSong(ctx, id); err != nil {
+			return fmt.Errorf("delete song %d: %w", id, err)
+		}
+		removed = append(removed, id)
+		if *format == "table" {
+			fmt.Printf("deleted song %d\n", id)
+		}
+	}
+	if *format == "json" {
+		return printJSON(os.Stdout, map[string]any{"deleted": removed})
+	}
+	return nil
+}
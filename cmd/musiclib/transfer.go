@@ -0,0 +1,167 @@
+package main
+
+import (
+	"Music_Library/internal/database/postgres"
+	"Music_Library/internal/models"
+	"Music_Library/internal/tags"
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const exportBatchSize = 500
+
+type importResult struct {
+	File    string `json:"file"`
+	SongID  uint   `json:"song_id"`
+	Group   string `json:"group"`
+	Title   string `json:"title"`
+	Created bool   `json:"created"`
+	Fields  string `json:"fields,omitempty"`
+}
+
+// importSongs создаёт или обновляет песни из тегов MP3 и FLAC файлов или из JSON, полученного командой export.
+func importSongs(e *env, args []string) error {
+	fs, format := newFlags("import", "FILE...", "table")
+	if err := e.parse(fs, format, args, 1, -1); err != nil {
+		return err
+	}
+
+	var results []importResult
+	for _, path := range fs.Args() {
+		songs, fields, err := readImportFile(path)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		for _, song := range songs {
+			created, err := postgres.ImportSong(e.ctx, song)
+			if err != nil {
+				return fmt.Errorf("%s: import %q by %q: %w", path, song.Title, song.Group, err)
+			}
+			results = append(results, importResult{
+				File:    path,
+				SongID:  song.ID,
+				Group:   song.Group,
+				Title:   song.Title,
+				Created: created,
+				Fields:  strings.Join(fields, ","),
+			})
+		}
+	}
+
+	if *format == "json" {
+		return printJSON(os.Stdout, results)
+	}
+	rows := make([][]string, len(results))
+	for i, r := range results {
+		rows[i] = []string{r.File, strconv.Itoa(int(r.SongID)), r.Group, r.Title, strconv.FormatBool(r.Created), r.Fields}
+	}
+	return printTable(os.Stdout, []string{"FILE", "SONG", "GROUP", "TITLE", "CREATED", "FIELDS FROM FILE"}, rows)
+}
+
+func readImportFile(path string) ([]*models.Song, []string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer file.Close()
+
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		var songs []*models.Song
+		if err = json.NewDecoder(file).Decode(&songs); err != nil {
+			return nil, nil, err
+		}
+		for _, song := range songs {
+			if song.Group == "" || song.Title == "" {
+				return nil, nil, fmt.Errorf("song %d has no group or title", song.ID)
+			}
+			// ID в другой базе не совпадают: песня ищется по группе и названию.
+			song.ID = 0
+			song.Artwork = nil
+			for i := range song.Lyrics {
+				song.Lyrics[i].ID = 0
+				song.Lyrics[i].SongID = 0
+			}
+		}
+		return songs, nil, nil
+	}
+
+	meta, err := tags.Read(file)
+	if err != nil {
+		return nil, nil, err
+	}
+	if meta.Artist == "" || meta.Title == "" {
+		return nil, nil, fmt.Errorf("file has no artist or title tags")
+	}
+	song, fields := meta.Song()
+	return []*models.Song{song}, fields, nil
+}
+
+// exportSongs выводит песни с куплетами в виде JSON-массива, который понимает import.
+func exportSongs(e *env, args []string) error {
+	fs, format := newFlags("export", "", "json")
+	group := fs.String("group", "", "export only songs of this group")
+	output := fs.String("file", "", "write to this file instead of standard output")
+	if err := e.parse(fs, format, args, 0, 0); err != nil {
+		return err
+	}
+
+	var out io.Writer = os.Stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		out = file
+	}
+	w := bufio.NewWriter(out)
+
+	if *format == "table" {
+		var rows [][]string
+		err := postgres.EachSong(e.ctx, postgres.SongFilter{Group: *group}, exportBatchSize, func(s *models.Song) error {
+			rows = append(rows, []string{strconv.Itoa(int(s.ID)), s.Group, s.Title, s.ReleaseDate.String(), strconv.Itoa(len(s.Lyrics))})
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if err = printTable(w, []string{"ID", "GROUP", "TITLE", "RELEASED", "VERSES"}, rows); err != nil {
+			return err
+		}
+		return w.Flush()
+	}
+
+	// Песни пишутся по одной, чтобы не держать в памяти весь каталог.
+	count := 0
+	fmt.Fprint(w, "[")
+	err := postgres.EachSong(e.ctx, postgres.SongFilter{Group: *group}, exportBatchSize, func(s *models.Song) error {
+		data, err := json.MarshalIndent(s, "  ", "  ")
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			fmt.Fprint(w, ",")
+		}
+		fmt.Fprint(w, "\n  ")
+		_, err = w.Write(data)
+		count++
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(w, "\n]\n")
+	if err = w.Flush(); err != nil {
+		return err
+	}
+	if *output != "" {
+		fmt.Fprintf(os.Stderr, "exported %d songs to %s\n", count, *output)
+	}
+	return nil
+}
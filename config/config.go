@@ -1,44 +1,277 @@
 package config
 
 import (
+	"errors"
+	"flag"
+	"fmt"
 	"github.com/ilyakaznacheev/cleanenv"
 	"log"
+	"net/url"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 )
 
+// DefaultPath используется, если путь не задан ни флагом --config, ни переменной CONFIG_PATH.
+const DefaultPath = "config/config.yaml"
+
+// Каждое поле можно переопределить переменной окружения: имя секции и ключа в верхнем регистре,
+// например HTTP_SERVER_ADDRESS или STORAGE_PASSWORD.
 type Config struct {
-	Env     string           `yaml:"env" env-default:"local"`
-	Server  HTTPServerConfig `yaml:"http_server"`
-	Storage StorageConfig    `yaml:"storage"`
+	Env      string           `yaml:"env" env:"ENV" env-default:"local"`
+	Server   HTTPServerConfig `yaml:"http_server" env-prefix:"HTTP_SERVER_"`
+	GRPC     GRPCServerConfig `yaml:"grpc_server" env-prefix:"GRPC_SERVER_"`
+	Storage  StorageConfig    `yaml:"storage" env-prefix:"STORAGE_"`
+	Blob     BlobConfig       `yaml:"blob_storage" env-prefix:"BLOB_STORAGE_"`
+	SongInfo SongInfoConfig   `yaml:"song_info" env-prefix:"SONG_INFO_"`
+	Webhooks WebhookConfig    `yaml:"webhooks" env-prefix:"WEBHOOKS_"`
+	Events   EventsConfig     `yaml:"events" env-prefix:"EVENTS_"`
+	Tracing  TracingConfig    `yaml:"tracing" env-prefix:"TRACING_"`
 }
 
 type HTTPServerConfig struct {
-	Address     string        `yaml:"address" env-default:":8080"`
-	Timeout     time.Duration `yaml:"timeout" env-default:"4s"`
-	IdleTimeout time.Duration `yaml:"idle_timeout" env-default:"60s"`
+	Address           string        `yaml:"address" env:"ADDRESS" env-default:":8080"`
+	ReadTimeout       time.Duration `yaml:"read_timeout" env:"READ_TIMEOUT" env-default:"10s"`
+	ReadHeaderTimeout time.Duration `yaml:"read_header_timeout" env:"READ_HEADER_TIMEOUT" env-default:"5s"`
+	WriteTimeout      time.Duration `yaml:"write_timeout" env:"WRITE_TIMEOUT" env-default:"10s"`
+	IdleTimeout       time.Duration `yaml:"idle_timeout" env:"IDLE_TIMEOUT" env-default:"60s"`
+	ShutdownTimeout   time.Duration `yaml:"shutdown_timeout" env:"SHUTDOWN_TIMEOUT" env-default:"15s"`
+	// Timeout — прежний общий таймаут, заменённый ReadTimeout и WriteTimeout. Читается только для того,
+	// чтобы Validate отклонил старый файл, а не запустил сервер с молча изменёнными таймаутами.
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+type GRPCServerConfig struct {
+	Enabled bool   `yaml:"enabled" env:"ENABLED" env-default:"true"`
+	Address string `yaml:"address" env:"ADDRESS" env-default:":9090"`
 }
 
 type StorageConfig struct {
-	Host        string `yaml:"host"`
-	Port        string `yaml:"port"`
-	Database    string `yaml:"database"`
-	Username    string `yaml:"username"`
-	Password    string `yaml:"password"`
-	UseInMemory bool   `yaml:"use_in_memory" env-default:"false"`
+	Host     string `yaml:"host" env:"HOST"`
+	Port     string `yaml:"port" env:"PORT"`
+	Database string `yaml:"database" env:"DATABASE"`
+	Username string `yaml:"username" env:"USERNAME"`
+	Password string `yaml:"password" env:"PASSWORD"`
+	// PasswordFile — путь к файлу с паролем, например Docker или Kubernetes secret.
+	// Если задан, пароль из файла заменяет Password.
+	PasswordFile string `yaml:"password_file" env:"PASSWORD_FILE"`
+	UseInMemory  bool   `yaml:"use_in_memory" env:"USE_IN_MEMORY" env-default:"false"`
+	// ConnectAttempts и ConnectInterval ограничивают ожидание базы данных при запуске.
+	ConnectAttempts int           `yaml:"connect_attempts" env:"CONNECT_ATTEMPTS" env-default:"10"`
+	ConnectInterval time.Duration `yaml:"connect_interval" env:"CONNECT_INTERVAL" env-default:"2s"`
+}
+
+type BlobConfig struct {
+	Driver string `yaml:"driver" env:"DRIVER" env-default:"local"`
+	Path   string `yaml:"path" env:"PATH" env-default:"data/blobs"`
+}
+
+type SongInfoConfig struct {
+	URL              string        `yaml:"url" env:"URL"`
+	Timeout          time.Duration `yaml:"timeout" env:"TIMEOUT" env-default:"3s"`
+	Deadline         time.Duration `yaml:"deadline" env:"DEADLINE" env-default:"4s"`
+	Retries          int           `yaml:"retries" env:"RETRIES" env-default:"2"`
+	Backoff          time.Duration `yaml:"backoff" env:"BACKOFF" env-default:"200ms"`
+	FailureThreshold int           `yaml:"failure_threshold" env:"FAILURE_THRESHOLD" env-default:"5"`
+	OpenTimeout      time.Duration `yaml:"open_timeout" env:"OPEN_TIMEOUT" env-default:"30s"`
 }
 
-func Load() *Config {
-	configPath := "config/config.yaml"
+type WebhookConfig struct {
+	PollInterval time.Duration `yaml:"poll_interval" env:"POLL_INTERVAL" env-default:"1s"`
+	BatchSize    int           `yaml:"batch_size" env:"BATCH_SIZE" env-default:"20"`
+	Timeout      time.Duration `yaml:"timeout" env:"TIMEOUT" env-default:"5s"`
+	MaxAttempts  int           `yaml:"max_attempts" env:"MAX_ATTEMPTS" env-default:"8"`
+	BaseBackoff  time.Duration `yaml:"base_backoff" env:"BASE_BACKOFF" env-default:"10s"`
+	MaxBackoff   time.Duration `yaml:"max_backoff" env:"MAX_BACKOFF" env-default:"1h"`
+}
+
+type EventsConfig struct {
+	LogSize int `yaml:"log_size" env:"LOG_SIZE" env-default:"1000"`
+}
 
-	if _, err := os.Stat(configPath); os.IsNotExist(err) {
-		log.Fatal("config file does not exist")
+type TracingConfig struct {
+	// Exporter — none, stdout, file или otlp.
+	Exporter    string  `yaml:"exporter" env:"EXPORTER" env-default:"none"`
+	File        string  `yaml:"file" env:"FILE" env-default:"data/traces.jsonl"`
+	Endpoint    string  `yaml:"endpoint" env:"ENDPOINT" env-default:"localhost:4318"`
+	Insecure    bool    `yaml:"insecure" env:"INSECURE" env-default:"true"`
+	SampleRatio float64 `yaml:"sample_ratio" env:"SAMPLE_RATIO" env-default:"1"`
+	ServiceName string  `yaml:"service_name" env:"SERVICE_NAME" env-default:"music-library"`
+}
+
+// MustLoad читает конфигурацию по пути из флага --config, переменной CONFIG_PATH или DefaultPath
+// и завершает процесс с описанием ошибки, если конфигурацию не удалось прочитать или она неверна.
+func MustLoad() *Config {
+	configPath := flag.String("config", "", "path to the config file (default $CONFIG_PATH or "+DefaultPath+")")
+	flag.Parse()
+
+	cfg, err := Load(Path(*configPath))
+	if err != nil {
+		log.Fatalf("cannot load config: %s", err)
 	}
+	return cfg
+}
 
+// Path выбирает путь к файлу конфигурации: значение флага, затем CONFIG_PATH, затем DefaultPath.
+func Path(flagValue string) string {
+	if flagValue != "" {
+		return flagValue
+	}
+	if env := os.Getenv("CONFIG_PATH"); env != "" {
+		return env
+	}
+	return DefaultPath
+}
+
+// Load читает файл конфигурации, применяет переменные окружения и секреты из файлов и проверяет значения.
+// Если файла по пути DefaultPath нет, конфигурация читается только из окружения.
+func Load(path string) (*Config, error) {
 	var cfg Config
-	if err := cleanenv.ReadConfig(configPath, &cfg); err != nil {
-		log.Fatal("cannot read config")
+	if _, err := os.Stat(path); err == nil {
+		if err = cleanenv.ReadConfig(path, &cfg); err != nil {
+			return nil, fmt.Errorf("read %s: %w", path, err)
+		}
+	} else if os.IsNotExist(err) && path == DefaultPath {
+		if err = cleanenv.ReadEnv(&cfg); err != nil {
+			return nil, fmt.Errorf("read environment: %w", err)
+		}
+	} else {
+		return nil, fmt.Errorf("config file %s: %w", path, err)
+	}
+
+	if err := cfg.readSecrets(); err != nil {
+		return nil, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config %s:\n%w", path, err)
+	}
+	return &cfg, nil
+}
+
+func (c *Config) readSecrets() error {
+	if c.Storage.PasswordFile == "" {
+		return nil
+	}
+	data, err := os.ReadFile(c.Storage.PasswordFile)
+	if err != nil {
+		return fmt.Errorf("storage.password_file: %w", err)
+	}
+	c.Storage.Password = strings.TrimRight(string(data), "\r\n")
+	return nil
+}
+
+// FieldError описывает ошибку в одном поле конфигурации.
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+func (e *FieldError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+// Validate проверяет обязательные поля и диапазоны значений и возвращает все найденные ошибки сразу.
+func (c *Config) Validate() error {
+	var errs []error
+	fail := func(field, format string, args ...any) {
+		errs = append(errs, &FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+	required := func(field, value string) {
+		if strings.TrimSpace(value) == "" {
+			fail(field, "is required")
+		}
+	}
+	positive := func(field string, d time.Duration) {
+		if d <= 0 {
+			fail(field, "must be positive, got %s", d)
+		}
+	}
+	atLeast := func(field string, value, limit int) {
+		if value < limit {
+			fail(field, "must be at least %d, got %d", limit, value)
+		}
+	}
+
+	switch c.Env {
+	case "local", "dev", "prod":
+	default:
+		fail("env", "must be one of local, dev, prod, got %q", c.Env)
+	}
+
+	required("http_server.address", c.Server.Address)
+	if c.Server.Timeout != 0 {
+		fail("http_server.timeout", "is no longer supported, set http_server.read_timeout and http_server.write_timeout instead")
+	}
+	positive("http_server.read_timeout", c.Server.ReadTimeout)
+	positive("http_server.read_header_timeout", c.Server.ReadHeaderTimeout)
+	positive("http_server.write_timeout", c.Server.WriteTimeout)
+	positive("http_server.idle_timeout", c.Server.IdleTimeout)
+	positive("http_server.shutdown_timeout", c.Server.ShutdownTimeout)
+
+	if c.GRPC.Enabled {
+		required("grpc_server.address", c.GRPC.Address)
+		if c.GRPC.Address != "" && c.GRPC.Address == c.Server.Address {
+			fail("grpc_server.address", "must differ from http_server.address")
+		}
+	}
+
+	required("storage.host", c.Storage.Host)
+	required("storage.database", c.Storage.Database)
+	required("storage.username", c.Storage.Username)
+	if port, err := strconv.Atoi(c.Storage.Port); err != nil || port < 1 || port > 65535 {
+		fail("storage.port", "must be a number from 1 to 65535, got %q", c.Storage.Port)
+	}
+	atLeast("storage.connect_attempts", c.Storage.ConnectAttempts, 1)
+	positive("storage.connect_interval", c.Storage.ConnectInterval)
+
+	if c.Blob.Driver != "local" {
+		fail("blob_storage.driver", "must be local, got %q", c.Blob.Driver)
+	}
+	required("blob_storage.path", c.Blob.Path)
+
+	if c.SongInfo.URL != "" {
+		if u, err := url.Parse(c.SongInfo.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			fail("song_info.url", "must be an absolute http or https URL, got %q", c.SongInfo.URL)
+		}
+	}
+	positive("song_info.timeout", c.SongInfo.Timeout)
+	positive("song_info.deadline", c.SongInfo.Deadline)
+	// Обогащение выполняется внутри запроса на создание песни и должно успеть до таймаута записи.
+	if c.SongInfo.Deadline >= c.Server.WriteTimeout/2 {
+		fail("song_info.deadline", "must be less than half of http_server.write_timeout (%s), got %s",
+			c.Server.WriteTimeout, c.SongInfo.Deadline)
+	}
+	atLeast("song_info.retries", c.SongInfo.Retries, 0)
+	positive("song_info.backoff", c.SongInfo.Backoff)
+	atLeast("song_info.failure_threshold", c.SongInfo.FailureThreshold, 1)
+	positive("song_info.open_timeout", c.SongInfo.OpenTimeout)
+
+	positive("webhooks.poll_interval", c.Webhooks.PollInterval)
+	atLeast("webhooks.batch_size", c.Webhooks.BatchSize, 1)
+	positive("webhooks.timeout", c.Webhooks.Timeout)
+	atLeast("webhooks.max_attempts", c.Webhooks.MaxAttempts, 1)
+	positive("webhooks.base_backoff", c.Webhooks.BaseBackoff)
+	if c.Webhooks.MaxBackoff < c.Webhooks.BaseBackoff {
+		fail("webhooks.max_backoff", "must not be less than webhooks.base_backoff (%s), got %s",
+			c.Webhooks.BaseBackoff, c.Webhooks.MaxBackoff)
+	}
+
+	atLeast("events.log_size", c.Events.LogSize, 1)
+
+	switch c.Tracing.Exporter {
+	case "none", "stdout":
+	case "file":
+		required("tracing.file", c.Tracing.File)
+	case "otlp":
+		required("tracing.endpoint", c.Tracing.Endpoint)
+	default:
+		fail("tracing.exporter", "must be one of none, stdout, file, otlp, got %q", c.Tracing.Exporter)
+	}
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		fail("tracing.sample_ratio", "must be between 0 and 1, got %g", c.Tracing.SampleRatio)
 	}
+	required("tracing.service_name", c.Tracing.ServiceName)
 
-	return &cfg
+	return errors.Join(errs...)
 }
@@ -15,6 +15,194 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/artwork/{key}": {
+            "get": {
+                "description": "Serves a cover art image. Image URLs change whenever the image changes, so responses can be cached forever.",
+                "produces": [
+                    "image/jpeg",
+                    "image/png"
+                ],
+                "tags": [
+                    "artwork"
+                ],
+                "summary": "Get a cover art image",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Image key from the song's artwork URL",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Image",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "404": {
+                        "description": "Image not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/batch": {
+            "post": {
+                "description": "Run up to 500 get, create, update, patch and delete operations on songs and lyrics in order. With atomic set all operations run in one transaction: the first failure rolls back the others, committed is false and the other operations get status 424. Otherwise every operation runs on its own. Either way the response is 200 with a status for each operation.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "batch"
+                ],
+                "summary": "Run a batch of operations",
+                "parameters": [
+                    {
+                        "description": "Operations to run",
+                        "name": "batch",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.BatchRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Results in the order of the operations",
+                        "schema": {
+                            "$ref": "#/definitions/models.BatchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid batch",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events": {
+            "get": {
+                "description": "Streams song and lyric create, update and delete events as Server-Sent Events. Each event has an id; after a disconnect the stream resumes from the Last-Event-ID header (or last_event_id parameter). If the missed events are no longer kept, a \"reset\" event is sent first and the client should reload its data.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "Live change feed",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Only events of these songs, comma-separated IDs",
+                        "name": "song_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only events of songs by this group",
+                        "name": "group",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the last received event",
+                        "name": "Last-Event-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the last received event, for clients that cannot set headers",
+                        "name": "last_event_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Event stream",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid filter",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/graphql": {
+            "post": {
+                "description": "Executes a GraphQL query or mutation over songs, lyrics and artists. Queries deeper than 8 levels or with complexity over 5000 are rejected; list fields count as many items as their pageSize (10 by default).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "graphql"
+                ],
+                "summary": "GraphQL endpoint",
+                "parameters": [
+                    {
+                        "description": "GraphQL request with query, variables and operationName",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "GraphQL response with data and errors",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or query over the limits",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/healthz": {
+            "get": {
+                "description": "Reports that the process is running. Dependencies are not checked.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Liveness probe",
+                "responses": {
+                    "200": {
+                        "description": "Process is alive",
+                        "schema": {
+                            "$ref": "#/definitions/models.HealthResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/lyrics": {
             "post": {
                 "description": "Adds a new lyric entry for a specific song in the database.",
@@ -47,7 +235,13 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Invalid input or the song does not exist",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -100,7 +294,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "Updates an existing lyric entry using its unique ID.",
+                "description": "Replaces an existing lyric entry using its unique ID. All fields are required; use PATCH to change only some of them.",
                 "consumes": [
                     "application/json"
                 ],
@@ -110,7 +304,7 @@ const docTemplate = `{
                 "tags": [
                     "Lyrics"
                 ],
-                "summary": "Update lyrics information",
+                "summary": "Replace lyrics information",
                 "parameters": [
                     {
                         "type": "integer",
@@ -121,7 +315,7 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Updated lyric object",
+                        "description": "New lyric object",
                         "name": "lyric",
                         "in": "body",
                         "required": true,
@@ -193,11 +387,108 @@ const docTemplate = `{
                         }
                     }
                 }
+            },
+            "patch": {
+                "description": "Changes some fields of a lyric with a JSON Merge Patch (RFC 7396) or a JSON Patch (RFC 6902), chosen by Content-Type. A null in a merge patch or a remove operation clears the field.",
+                "consumes": [
+                    "application/merge-patch+json",
+                    "application/json-patch+json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Lyrics"
+                ],
+                "summary": "Partially update a lyric",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int",
+                        "description": "Lyric ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Merge patch object or JSON Patch operations",
+                        "name": "patch",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully updated lyrics",
+                        "schema": {
+                            "$ref": "#/definitions/models.Lyric"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid lyric ID, malformed patch or invalid result",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Lyrics not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "A test operation failed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Content-Type",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "The patch cannot be applied to the lyric",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/readyz": {
+            "get": {
+                "description": "Checks the database connection, the schema migration version and blob storage, and reports the status of each dependency.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Readiness probe",
+                "responses": {
+                    "200": {
+                        "description": "All dependencies are up",
+                        "schema": {
+                            "$ref": "#/definitions/models.ReadinessResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Some dependency is down",
+                        "schema": {
+                            "$ref": "#/definitions/models.ReadinessResponse"
+                        }
+                    }
+                }
             }
         },
         "/songs": {
             "get": {
-                "description": "Fetch a list of all songs, with optional filters for group, title, release date, and link. Pagination is supported with offset and page_size parameters.",
+                "description": "Fetch a list of all songs, with optional filters for group, title, release date, and link. Pagination is supported with offset and page_size parameters, and the order is set with sort. Lyrics are returned only with include=lyrics.",
                 "consumes": [
                     "application/json"
                 ],
@@ -209,6 +500,12 @@ const docTemplate = `{
                 ],
                 "summary": "Get all songs",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma-separated song IDs to fetch in one query, at most 100; page_size defaults to their number",
+                        "name": "ids",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
                         "description": "Filter songs by group name",
@@ -223,16 +520,52 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Filter songs by release date (YYYY-MM-DD)",
+                        "description": "Filter songs by exact release date and precision (YYYY-MM-DD, YYYY-MM or YYYY)",
                         "name": "release_date",
                         "in": "query"
                     },
+                    {
+                        "type": "string",
+                        "description": "Only songs released on or after this date; a year or month means its first day",
+                        "name": "released_after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only songs released on or before this date; a year or month means its last day",
+                        "name": "released_before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Only songs released in this year",
+                        "name": "year",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
                         "description": "Filter songs by associated link",
                         "name": "link",
                         "in": "query"
                     },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated fields to sort by, prefixed with - for descending order: id, group, title, release_date, link, lyric_count (default: id)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated song fields to return: group, title, release_date, link (default: all; ID is always returned)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated relations to load: lyrics, artwork (default: none)",
+                        "name": "include",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
                         "description": "Pagination offset, starting from 0 (default: 0)",
@@ -241,7 +574,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "integer",
-                        "description": "Number of items per page (default: 10)",
+                        "description": "Number of items per page, at most 100 (default: 10)",
                         "name": "page_size",
                         "in": "query"
                     }
@@ -256,6 +589,12 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "400": {
+                        "description": "Invalid filter, sort, fields, include, offset or page size",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -265,7 +604,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Add a new song to the database",
+                "description": "Add a new song to the database. Missing release date, link and lyrics are filled in from the song info service when it is configured.",
                 "consumes": [
                     "application/json"
                 ],
@@ -303,11 +642,11 @@ const docTemplate = `{
                 }
             }
         },
-        "/songs/{id}": {
-            "get": {
-                "description": "Fetch details of a specific song by its ID",
+        "/songs/upload": {
+            "post": {
+                "description": "Reads ID3v2 (MP3) or Vorbis comment (FLAC) tags from the uploaded file and creates or updates the song with the same group and title. Unsynchronized lyrics are split into verses by blank lines and replace the stored ones.",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
@@ -315,14 +654,82 @@ const docTemplate = `{
                 "tags": [
                     "songs"
                 ],
-                "summary": "Get song by ID",
+                "summary": "Create a song from an audio file",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "ID of the song",
-                        "name": "id",
+                        "type": "file",
+                        "description": "MP3 or FLAC file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Imported song and the fields taken from the file",
+                        "schema": {
+                            "$ref": "#/definitions/models.ImportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing file or unsupported format",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "File is too large",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "File has no artist or title tags",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/songs/{id}": {
+            "get": {
+                "description": "Fetch details of a specific song by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "songs"
+                ],
+                "summary": "Get song by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID of the song",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated song fields to return: group, title, release_date, link (default: all; ID is always returned)",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated relations to load: lyrics, artwork (default: lyrics,artwork)",
+                        "name": "include",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -332,6 +739,99 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.Song"
                         }
                     },
+                    "400": {
+                        "description": "Invalid song ID, fields or include",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Song not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replace the song with the given ID. Fields missing from the body are cleared, lyrics with an ID are updated, lyrics without one are added and the song's other lyrics are deleted. Use PATCH to change only some fields.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "songs"
+                ],
+                "summary": "Replace an existing song",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID of the song to be replaced",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New song details",
+                        "name": "song",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Song"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated song details",
+                        "schema": {
+                            "$ref": "#/definitions/models.Song"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input data",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Song not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a song from the database using its ID, together with its lyrics, audio file and cover art. Stored files that no other song uses are deleted from blob storage.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "songs"
+                ],
+                "summary": "Delete a song",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID of the song to be deleted",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "ID of the deleted song",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
                     "400": {
                         "description": "Invalid song ID",
                         "schema": {
@@ -346,93 +846,809 @@ const docTemplate = `{
                     }
                 }
             },
+            "patch": {
+                "description": "Change some fields of the song with a JSON Merge Patch (RFC 7396) or a JSON Patch (RFC 6902), chosen by Content-Type. A null in a merge patch or a remove operation clears the field. The patch is applied to the song with its lyrics, and lyrics are saved as with PUT.",
+                "consumes": [
+                    "application/merge-patch+json",
+                    "application/json-patch+json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "songs"
+                ],
+                "summary": "Partially update a song",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID of the song to be updated",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Merge patch object or JSON Patch operations",
+                        "name": "patch",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated song details",
+                        "schema": {
+                            "$ref": "#/definitions/models.Song"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid song ID, malformed patch or invalid result",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Song not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "A test operation failed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Content-Type",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "The patch cannot be applied to the song",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/songs/{id}/artwork": {
             "put": {
-                "description": "Update song details by its ID, such as title, group, or release date",
+                "description": "Validates a JPEG or PNG image (at most 10 MB, between 300x300 and 6000x6000 pixels), generates small (100px), medium (300px) and large (600px) thumbnails and replaces the song's cover art.",
                 "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "artwork"
+                ],
+                "summary": "Upload cover art for a song",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID of the song",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "JPEG or PNG image",
+                        "name": "image",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Stored image sizes",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Artwork"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid song ID or image",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Song not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Image is too large",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Removes all sizes of the song's cover art",
+                "produces": [
                     "application/json"
                 ],
+                "tags": [
+                    "artwork"
+                ],
+                "summary": "Delete the cover art of a song",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID of the song",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "ID of the song",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid song ID",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Song has no cover art",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/songs/{id}/audio": {
+            "get": {
+                "description": "Streams the attached audio file. Supports Range requests for seeking and conditional requests with ETag.",
+                "produces": [
+                    "audio/mpeg",
+                    "audio/flac"
+                ],
+                "tags": [
+                    "audio"
+                ],
+                "summary": "Stream the audio file of a song",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID of the song",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Byte range, e.g. bytes=0-1023",
+                        "name": "Range",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Whole audio file",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "206": {
+                        "description": "Requested byte range",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "304": {
+                        "description": "Not modified",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid song ID",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Song has no audio file",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "416": {
+                        "description": "Range not satisfiable",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Stores the uploaded audio file and attaches it to the song, replacing the previous one. Files are stored by their SHA-256 checksum, so identical uploads are kept only once.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "audio"
+                ],
+                "summary": "Attach an audio file to a song",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID of the song",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Audio file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Attached audio file",
+                        "schema": {
+                            "$ref": "#/definitions/models.AudioFile"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid song ID or not an audio file",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Song not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "File is too large",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Removes the audio file from the song. The stored file is deleted when no other song uses it.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "audio"
+                ],
+                "summary": "Detach the audio file from a song",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID of the song",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "ID of the song",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid song ID",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Song has no audio file",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/songs/{id}/lyrics": {
+            "get": {
+                "description": "Returns the lyrics of a song ordered by verse number. Pagination is supported with offset and page_size parameters, counted in verses.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Lyrics"
+                ],
+                "summary": "List the verses of a song",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Song ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of verses to skip (default: 0)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of verses per page, at most 100 (default: 10)",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Verses with pagination metadata",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Lyric"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid song ID, offset or page size",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Song not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds a verse to the song from the path. song_id may be omitted from the body; if given, it must match the path. A verse number that is already taken is a conflict unless insert is true: then the verse is inserted at that number and the verses from it on are moved one number down.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Lyrics"
+                ],
+                "summary": "Add a verse to a song",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Song ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Shift later verses to make room (default: false)",
+                        "name": "insert",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Verse number and text",
+                        "name": "lyric",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Lyric"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created verse",
+                        "schema": {
+                            "$ref": "#/definitions/models.Lyric"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Song not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Verse number is taken",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/songs/{id}/lyrics/reorder": {
+            "post": {
+                "description": "Renumbers the verses of a song from 1 in the order of ids in one transaction. ids must list every verse of the song exactly once.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Lyrics"
+                ],
+                "summary": "Reorder the verses of a song",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Song ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Verse IDs in the new order",
+                        "name": "order",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.LyricOrder"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Verses in the new order",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Lyric"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input or ids does not list every verse of the song once",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Song not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/songs/{id}/lyrics/{verse_number}": {
+            "get": {
+                "description": "Returns the verse of a song with the given verse number.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Lyrics"
+                ],
+                "summary": "Get one verse of a song",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Song ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Verse number",
+                        "name": "verse_number",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "The verse",
+                        "schema": {
+                            "$ref": "#/definitions/models.Lyric"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid song ID or verse number",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Song or verse not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks": {
+            "get": {
+                "description": "Returns all webhook subscriptions without their secrets",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "List webhooks",
+                "responses": {
+                    "200": {
+                        "description": "Webhooks",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Webhook"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates a webhook that receives signed JSON payloads for the given event types (song.created, song.updated, song.deleted, lyric.created, lyric.updated, lyric.deleted). Patterns such as \"song.*\" and \"*\" are accepted. If no secret is sent, one is generated; it is returned only in this response.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Subscribe to catalogue changes",
+                "parameters": [
+                    {
+                        "description": "Webhook URL, event types and optional secret",
+                        "name": "webhook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.WebhookInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Created webhook with its secret",
+                        "schema": {
+                            "$ref": "#/definitions/models.Webhook"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid URL or event types",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}": {
+            "get": {
+                "description": "Returns a webhook subscription without its secret",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Get webhook by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Webhook",
+                        "schema": {
+                            "$ref": "#/definitions/models.Webhook"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid webhook ID",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Removes a webhook subscription together with its delivery log",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Delete a webhook",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "ID of the deleted webhook",
+                        "schema": {
+                            "$ref": "#/definitions/models.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid webhook ID",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}/deliveries": {
+            "get": {
+                "description": "Returns delivery attempts of a webhook, newest first. Pagination is supported with offset and page_size parameters.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "songs"
+                    "webhooks"
                 ],
-                "summary": "Update an existing song",
+                "summary": "Get webhook delivery log",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID of the song to be updated",
+                        "description": "Webhook ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Updated song details",
-                        "name": "song",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.Song"
-                        }
+                        "type": "integer",
+                        "description": "Pagination offset, starting from 0 (default: 0)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of items per page, at most 100 (default: 10)",
+                        "name": "page_size",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Updated song details",
+                        "description": "Deliveries with pagination metadata",
                         "schema": {
-                            "$ref": "#/definitions/models.Song"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.WebhookDelivery"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Invalid input data",
+                        "description": "Invalid webhook ID, offset or page size",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Song not found",
+                        "description": "Webhook not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete a song from the database using its ID",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/webhooks/{id}/deliveries/{delivery_id}/redeliver": {
+            "post": {
+                "description": "Queues the payload of an earlier delivery again. The new attempt gets its own entry in the delivery log.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "songs"
+                    "webhooks"
                 ],
-                "summary": "Delete a song",
+                "summary": "Redeliver a webhook event",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID of the song to be deleted",
+                        "description": "Webhook ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Delivery ID",
+                        "name": "delivery_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "ID of the deleted song",
+                        "description": "Queued delivery",
                         "schema": {
-                            "$ref": "#/definitions/models.Response"
+                            "$ref": "#/definitions/models.WebhookDelivery"
                         }
                     },
                     "400": {
-                        "description": "Invalid song ID",
+                        "description": "Invalid ID",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Song not found",
+                        "description": "Delivery not found",
                         "schema": {
                             "$ref": "#/definitions/models.ErrorResponse"
                         }
@@ -442,17 +1658,264 @@ const docTemplate = `{
         }
     },
     "definitions": {
-        "models.ErrorResponse": {
+        "models.Artwork": {
+            "description": "Cover art image of a fixed size",
+            "type": "object",
+            "properties": {
+                "content_type": {
+                    "type": "string"
+                },
+                "height": {
+                    "type": "integer"
+                },
+                "size": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "width": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.AudioFile": {
+            "description": "Audio file metadata",
+            "type": "object",
+            "properties": {
+                "checksum": {
+                    "type": "string"
+                },
+                "content_type": {
+                    "type": "string"
+                },
+                "filename": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "song_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.BatchError": {
+            "description": "Error of one operation",
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "not_found"
+                },
+                "detail": {
+                    "type": "string",
+                    "example": "song with id 42 not found"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.FieldError"
+                    }
+                }
+            }
+        },
+        "models.BatchOperation": {
+            "description": "Operation on a song or a lyric",
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "Data — тело операции: объект для create и update, JSON Merge Patch для patch.",
+                    "type": "object"
+                },
+                "id": {
+                    "description": "ID обязателен для всех операций, кроме create.",
+                    "type": "integer",
+                    "example": 42
+                },
+                "op": {
+                    "type": "string",
+                    "enum": [
+                        "get",
+                        "create",
+                        "update",
+                        "patch",
+                        "delete"
+                    ],
+                    "example": "create"
+                },
+                "resource": {
+                    "type": "string",
+                    "enum": [
+                        "song",
+                        "lyric"
+                    ],
+                    "example": "song"
+                }
+            }
+        },
+        "models.BatchRequest": {
+            "description": "Batch of operations executed in one request",
+            "type": "object",
+            "properties": {
+                "atomic": {
+                    "description": "Atomic выполняет все операции в одной транзакции: при первой ошибке изменения откатываются.",
+                    "type": "boolean"
+                },
+                "operations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BatchOperation"
+                    }
+                }
+            }
+        },
+        "models.BatchResponse": {
+            "description": "Results of a batch in the order of its operations",
+            "type": "object",
+            "properties": {
+                "committed": {
+                    "description": "Committed равен false, если атомарный пакет откатился.",
+                    "type": "boolean"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BatchResult"
+                    }
+                }
+            }
+        },
+        "models.BatchResult": {
+            "description": "Outcome of one operation",
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object"
+                },
+                "error": {
+                    "$ref": "#/definitions/models.BatchError"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 201
+                }
+            }
+        },
+        "models.CheckResult": {
+            "description": "Dependency check result",
             "type": "object",
             "properties": {
+                "details": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
                 "error": {
                     "type": "string"
+                },
+                "latency_ms": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "up"
+                }
+            }
+        },
+        "models.ErrorResponse": {
+            "description": "Problem details (application/problem+json)",
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "not_found"
+                },
+                "detail": {
+                    "type": "string",
+                    "example": "song with id 42 not found"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.FieldError"
+                    }
+                },
+                "instance": {
+                    "type": "string",
+                    "example": "/songs/42"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Not Found"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "about:blank"
+                }
+            }
+        },
+        "models.FieldError": {
+            "description": "Invalid field",
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "not_found"
+                },
+                "field": {
+                    "type": "string",
+                    "example": "song_id"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "references a record that does not exist"
+                }
+            }
+        },
+        "models.HealthResponse": {
+            "description": "Liveness status",
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "example": "ok"
+                }
+            }
+        },
+        "models.ImportResponse": {
+            "description": "Song import result",
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "boolean"
+                },
+                "fields_from_file": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "song": {
+                    "$ref": "#/definitions/models.Song"
                 }
             }
         },
         "models.Lyric": {
             "description": "Song lyrics model",
             "type": "object",
+            "required": [
+                "text"
+            ],
             "properties": {
                 "id": {
                     "type": "integer"
@@ -468,6 +1931,39 @@ const docTemplate = `{
                 }
             }
         },
+        "models.LyricOrder": {
+            "description": "IDs of all verses of a song in the desired order",
+            "type": "object",
+            "properties": {
+                "ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        7,
+                        5,
+                        6
+                    ]
+                }
+            }
+        },
+        "models.ReadinessResponse": {
+            "description": "Readiness status",
+            "type": "object",
+            "properties": {
+                "checks": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/models.CheckResult"
+                    }
+                },
+                "status": {
+                    "type": "string",
+                    "example": "ready"
+                }
+            }
+        },
         "models.Response": {
             "type": "object",
             "properties": {
@@ -482,9 +1978,20 @@ const docTemplate = `{
         "models.Song": {
             "description": "Song model",
             "type": "object",
+            "required": [
+                "group",
+                "title"
+            ],
             "properties": {
+                "artwork": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Artwork"
+                    }
+                },
                 "group": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 255
                 },
                 "id": {
                     "type": "integer"
@@ -499,10 +2006,102 @@ const docTemplate = `{
                     }
                 },
                 "release_date": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "2006-07-16"
                 },
                 "title": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "models.Webhook": {
+            "description": "Webhook subscription",
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "secret": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.WebhookDelivery": {
+            "description": "Webhook delivery log entry",
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "delivered_at": {
+                    "type": "string"
+                },
+                "event": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "next_attempt_at": {
+                    "type": "string"
+                },
+                "payload": {
+                    "type": "string"
+                },
+                "response_status": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "webhook_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.WebhookInput": {
+            "description": "Webhook URL, event types and optional secret",
+            "type": "object",
+            "properties": {
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "song.*",
+                        "lyric.created"
+                    ]
+                },
+                "secret": {
+                    "description": "Secret подписывает доставки; если его нет, сервер создаёт свой.",
                     "type": "string"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://example.com/hooks/music"
                 }
             }
         }
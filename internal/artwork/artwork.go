@@ -0,0 +1,120 @@
+package artwork
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+
+	"golang.org/x/image/draw"
+)
+
+const (
+	MaxFileSize  = 10 << 20
+	MinDimension = 300
+	MaxDimension = 6000
+)
+
+var ErrUnsupportedFormat = errors.New("unsupported image format, only JPEG and PNG are accepted")
+
+// Size описывает одну из миниатюр: изображение вписывается в квадрат со стороной Dimension.
+type Size struct {
+	Name      string
+	Dimension int
+}
+
+// Sizes — фиксированный набор миниатюр, которые генерируются для каждой обложки.
+var Sizes = []Size{
+	{Name: "small", Dimension: 100},
+	{Name: "medium", Dimension: 300},
+	{Name: "large", Dimension: 600},
+}
+
+// Rendition — закодированное изображение одного размера.
+type Rendition struct {
+	Size        string
+	Width       int
+	Height      int
+	ContentType string
+	Extension   string
+	Data        []byte
+}
+
+// Process проверяет загруженное изображение и генерирует набор миниатюр.
+// Первым элементом результата идёт оригинал без изменений.
+func Process(data []byte) ([]Rendition, error) {
+	if len(data) > MaxFileSize {
+		return nil, fmt.Errorf("image must not exceed %d bytes", MaxFileSize)
+	}
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || (format != "jpeg" && format != "png") {
+		return nil, ErrUnsupportedFormat
+	}
+	if cfg.Width < MinDimension || cfg.Height < MinDimension {
+		return nil, fmt.Errorf("image must be at least %dx%d pixels", MinDimension, MinDimension)
+	}
+	if cfg.Width > MaxDimension || cfg.Height > MaxDimension {
+		return nil, fmt.Errorf("image must be at most %dx%d pixels", MaxDimension, MaxDimension)
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedFormat
+	}
+
+	contentType, ext := "image/jpeg", ".jpg"
+	if format == "png" {
+		contentType, ext = "image/png", ".png"
+	}
+
+	renditions := []Rendition{{
+		Size:        "original",
+		Width:       cfg.Width,
+		Height:      cfg.Height,
+		ContentType: contentType,
+		Extension:   ext,
+		Data:        data,
+	}}
+	for _, size := range Sizes {
+		thumb := resize(src, size.Dimension)
+		var buf bytes.Buffer
+		if format == "png" {
+			err = png.Encode(&buf, thumb)
+		} else {
+			err = jpeg.Encode(&buf, thumb, &jpeg.Options{Quality: 85})
+		}
+		if err != nil {
+			return nil, err
+		}
+		renditions = append(renditions, Rendition{
+			Size:        size.Name,
+			Width:       thumb.Bounds().Dx(),
+			Height:      thumb.Bounds().Dy(),
+			ContentType: contentType,
+			Extension:   ext,
+			Data:        buf.Bytes(),
+		})
+	}
+	return renditions, nil
+}
+
+// resize вписывает изображение в квадрат со стороной side, сохраняя пропорции.
+// Изображения меньше квадрата не увеличиваются.
+func resize(src image.Image, side int) image.Image {
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	switch {
+	case w <= side && h <= side:
+	case w >= h:
+		h = max(h*side/w, 1)
+		w = side
+	default:
+		w = max(w*side/h, 1)
+		h = side
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, b, draw.Src, nil)
+	return dst
+}
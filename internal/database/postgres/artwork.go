@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"Music_Library/internal/logging"
+	"Music_Library/internal/models"
+	"Music_Library/internal/storage"
+	"context"
+	"gorm.io/gorm"
+	"log/slog"
+)
+
+// ReplaceArtwork заменяет обложку песни новым набором изображений.
+// Возвращает ключи изображений, которые были заменены.
+func ReplaceArtwork(ctx context.Context, songID uint, artwork []models.Artwork) ([]string, error) {
+	ctx, span := startSpan(ctx, "ReplaceArtwork")
+	defer span.End()
+
+	var previous []string
+	err := conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.First(&models.Song{}, songID).Error; err != nil {
+			return translate(err, "song", "id", songID)
+		}
+		if err := tx.Model(&models.Artwork{}).Where("song_id = ?", songID).Pluck("key", &previous).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("song_id = ?", songID).Delete(&models.Artwork{}).Error; err != nil {
+			return err
+		}
+		for i := range artwork {
+			artwork[i].SongID = songID
+		}
+		return tx.Create(&artwork).Error
+	})
+	return previous, translate(err, "artwork", "song_id", songID)
+}
+
+// DeleteArtwork удаляет обложку песни и возвращает ключи удалённых изображений.
+func DeleteArtwork(ctx context.Context, songID uint) ([]string, error) {
+	ctx, span := startSpan(ctx, "DeleteArtwork")
+	defer span.End()
+
+	var keys []string
+	err := conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.Artwork{}).Where("song_id = ?", songID).Pluck("key", &keys).Error; err != nil {
+			return err
+		}
+		if len(keys) == 0 {
+			return &NotFoundError{Entity: "artwork", Field: "song_id", Value: songID}
+		}
+		return tx.Where("song_id = ?", songID).Delete(&models.Artwork{}).Error
+	})
+	return keys, err
+}
+
+// GetArtworkByKey возвращает изображение обложки по ключу в хранилище.
+func GetArtworkByKey(ctx context.Context, key string) (*models.Artwork, error) {
+	ctx, span := startSpan(ctx, "GetArtworkByKey")
+	defer span.End()
+
+	var artwork models.Artwork
+	result := conn(ctx).Where("key = ?", key).First(&artwork)
+	if result.Error != nil {
+		return nil, translate(result.Error, "artwork", "key", key)
+	}
+	return &artwork, nil
+}
+
+// ArtworkKeyInUse проверяет, ссылается ли ещё какая-нибудь обложка на изображение с этим ключом.
+func ArtworkKeyInUse(ctx context.Context, key string) (bool, error) {
+	ctx, span := startSpan(ctx, "ArtworkKeyInUse")
+	defer span.End()
+
+	var count int64
+	result := conn(ctx).Model(&models.Artwork{}).Where("key = ?", key).Count(&count)
+	return count > 0, result.Error
+}
+
+// ReleaseArtwork удаляет из хранилища изображения с ключами keys, на которые больше не ссылается
+// ни одна обложка. Как и в ReleaseAudio, проверка и удаление идут под блокировкой WithBlobs,
+// а ошибки пишутся в логгер из ctx.
+func ReleaseArtwork(ctx context.Context, keys []string) {
+	log := logging.FromContext(ctx, slog.Default())
+	for _, key := range keys {
+		err := WithBlobs(ctx, []string{key}, func(ctx context.Context) error {
+			inUse, err := ArtworkKeyInUse(ctx, key)
+			if err != nil || inUse {
+				return err
+			}
+			return storage.Blobs.Delete(key)
+		})
+		if err != nil {
+			log.Error("Failed to release cover art blob", "key", key, "error", err)
+		}
+	}
+}
+
+// GetArtworkBySongIDs возвращает обложки нескольких песен одним запросом.
+func GetArtworkBySongIDs(ctx context.Context, songIDs []uint) ([]models.Artwork, error) {
+	ctx, span := startSpan(ctx, "GetArtworkBySongIDs")
+	defer span.End()
+
+	var artwork []models.Artwork
+	result := conn(ctx).Where("song_id IN ?", songIDs).Order("song_id, id").Find(&artwork)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return artwork, nil
+}
@@ -0,0 +1,93 @@
+package postgres
+
+import (
+	"Music_Library/internal/logging"
+	"Music_Library/internal/models"
+	"Music_Library/internal/storage"
+	"context"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"log/slog"
+)
+
+// GetAudioFile возвращает аудиофайл, прикреплённый к песне.
+func GetAudioFile(ctx context.Context, songID uint) (*models.AudioFile, error) {
+	ctx, span := startSpan(ctx, "GetAudioFile")
+	defer span.End()
+
+	var audio models.AudioFile
+	result := conn(ctx).Where("song_id = ?", songID).First(&audio)
+	if result.Error != nil {
+		return nil, translate(result.Error, "audio file", "song_id", songID)
+	}
+	return &audio, nil
+}
+
+// SaveAudioFile прикрепляет аудиофайл к песне, заменяя предыдущий.
+// Возвращает контрольную сумму заменённого файла, если она была.
+func SaveAudioFile(ctx context.Context, audio *models.AudioFile) (string, error) {
+	ctx, span := startSpan(ctx, "SaveAudioFile")
+	defer span.End()
+
+	var previous string
+	err := conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.First(&models.Song{}, audio.SongID).Error; err != nil {
+			return translate(err, "song", "id", audio.SongID)
+		}
+		var old models.AudioFile
+		if err := tx.Where("song_id = ?", audio.SongID).Limit(1).Find(&old).Error; err != nil {
+			return err
+		}
+		previous = old.Checksum
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "song_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"checksum", "size", "content_type", "filename"}),
+		}).Create(audio).Error
+	})
+	return previous, translate(err, "audio file", "song_id", audio.SongID)
+}
+
+// DeleteAudioFile открепляет аудиофайл от песни и возвращает его контрольную сумму.
+func DeleteAudioFile(ctx context.Context, songID uint) (string, error) {
+	ctx, span := startSpan(ctx, "DeleteAudioFile")
+	defer span.End()
+
+	var audio models.AudioFile
+	result := conn(ctx).Clauses(clause.Returning{}).Where("song_id = ?", songID).Delete(&audio)
+	if result.Error != nil {
+		return "", result.Error
+	}
+	if result.RowsAffected == 0 {
+		return "", &NotFoundError{Entity: "audio file", Field: "song_id", Value: songID}
+	}
+	return audio.Checksum, nil
+}
+
+// AudioChecksumInUse проверяет, ссылается ли ещё какая-нибудь песня на файл с этой суммой.
+func AudioChecksumInUse(ctx context.Context, checksum string) (bool, error) {
+	ctx, span := startSpan(ctx, "AudioChecksumInUse")
+	defer span.End()
+
+	var count int64
+	result := conn(ctx).Model(&models.AudioFile{}).Where("checksum = ?", checksum).Count(&count)
+	return count > 0, result.Error
+}
+
+// ReleaseAudio удаляет файл с контрольной суммой checksum из хранилища, если на него больше
+// не ссылается ни одна песня; проверка и удаление идут под блокировкой WithBlobs. Ошибки пишутся
+// в логгер из ctx: запись о файле к этому моменту уже удалена, и вызывающему нечего с ними делать.
+func ReleaseAudio(ctx context.Context, checksum string) {
+	if checksum == "" {
+		return
+	}
+	err := WithBlobs(ctx, []string{checksum}, func(ctx context.Context) error {
+		inUse, err := AudioChecksumInUse(ctx, checksum)
+		if err != nil || inUse {
+			return err
+		}
+		return storage.Blobs.Delete(checksum)
+	})
+	if err != nil {
+		logging.FromContext(ctx, slog.Default()).Error("Failed to release audio blob", "checksum", checksum, "error", err)
+	}
+}
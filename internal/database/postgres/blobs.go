@@ -0,0 +1,22 @@
+package postgres
+
+import (
+	"context"
+	"slices"
+)
+
+// WithBlobs выполняет fn в транзакции, удерживая до её конца блокировки ключей keys в хранилище.
+// Загрузка, которая внутри WithBlobs проверяет или сохраняет файл и записывает ссылку на него,
+// не пересекается с ReleaseAudio и ReleaseArtwork: те берут ту же блокировку, перепроверяют
+// ссылки и удаляют файл, только пока их никто не держит.
+func WithBlobs(ctx context.Context, keys []string, fn func(ctx context.Context) error) error {
+	return InTransaction(ctx, func(ctx context.Context) error {
+		// Ключи блокируются по порядку, чтобы две загрузки не ждали друг друга.
+		for _, key := range slices.Sorted(slices.Values(keys)) {
+			if err := conn(ctx).Exec("SELECT pg_advisory_xact_lock(hashtextextended(?, 0))", key).Error; err != nil {
+				return err
+			}
+		}
+		return fn(ctx)
+	})
+}
@@ -2,35 +2,75 @@ package postgres
 
 import (
 	"Music_Library/config"
-	"Music_Library/internal/models"
+	"context"
 	"fmt"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
 	"log/slog"
+	"time"
 )
 
 var DB *gorm.DB
 
-func SetupDatabase(log *slog.Logger, cfg *config.Config) {
-	host := cfg.Storage.Host
-	port := cfg.Storage.Port
-	user := cfg.Storage.Username
-	name := cfg.Storage.Database
-	password := cfg.Storage.Password
+// SetupDatabase подключается к базе данных и применяет миграции. Пока база недоступна,
+// подключение повторяется storage.connect_attempts раз с паузой storage.connect_interval.
+func SetupDatabase(log *slog.Logger, cfg *config.Config) error {
+	var err error
+	for attempt := 1; attempt <= cfg.Storage.ConnectAttempts; attempt++ {
+		if err = Connect(cfg, logger.Default); err == nil {
+			ctx, cancel := context.WithTimeout(context.Background(), cfg.Storage.ConnectInterval)
+			err = Ping(ctx)
+			cancel()
+		}
+		if err == nil {
+			break
+		}
+		log.Warn("Database is not available yet", "attempt", attempt, "of", cfg.Storage.ConnectAttempts, "error", err)
+		_ = Close()
+		if attempt < cfg.Storage.ConnectAttempts {
+			time.Sleep(cfg.Storage.ConnectInterval)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("connect to database: %w", err)
+	}
+	log.Info("Database connection established")
 
+	if err = Migrate(); err != nil {
+		return fmt.Errorf("migrate database: %w", err)
+	}
+	log.Info("Database schema is up to date", "version", LatestVersion())
+	return nil
+}
+
+// Connect открывает соединение с базой данных из настроек storage.
+func Connect(cfg *config.Config, gormLogger logger.Interface) error {
 	dsn := fmt.Sprintf("host=%s user=%s dbname=%s sslmode=disable password=%s port=%s",
-		host, user, name, password, port)
+		cfg.Storage.Host, cfg.Storage.Username, cfg.Storage.Database, cfg.Storage.Password, cfg.Storage.Port)
 
 	var err error
-	DB, err = gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	DB, err = gorm.Open(postgres.Open(dsn), &gorm.Config{Logger: gormLogger})
+	return err
+}
+
+// Ping проверяет, что база данных отвечает.
+func Ping(ctx context.Context) error {
+	sqlDB, err := DB.DB()
 	if err != nil {
-		log.Error("Failed to connect to database")
-	} else {
-		log.Info("Database connection established")
+		return err
 	}
+	return sqlDB.PingContext(ctx)
+}
 
-	err = DB.AutoMigrate(&models.Song{}, &models.Lyric{})
+// Close закрывает пул соединений с базой данных.
+func Close() error {
+	if DB == nil {
+		return nil
+	}
+	sqlDB, err := DB.DB()
 	if err != nil {
-		log.Error("Failed to migrate database")
+		return err
 	}
+	return sqlDB.Close()
 }
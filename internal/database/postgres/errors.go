@@ -0,0 +1,136 @@
+package postgres
+
+import (
+	"Music_Library/internal/models"
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+	"regexp"
+	"strings"
+)
+
+// Ошибки репозитория. Функции пакета возвращают NotFoundError, ConflictError и ValidationError,
+// которые сравниваются с этими значениями через errors.Is.
+var (
+	ErrNotFound   = errors.New("record not found")
+	ErrConflict   = errors.New("conflict")
+	ErrValidation = errors.New("validation failed")
+)
+
+// Коды ошибок PostgreSQL, которые переводятся в ошибки репозитория.
+const (
+	pgStringTooLong       = "22001"
+	pgNotNullViolation    = "23502"
+	pgForeignKeyViolation = "23503"
+	pgUniqueViolation     = "23505"
+	pgCheckViolation      = "23514"
+)
+
+// NotFoundError — запись Entity, у которой Field равно Value, не найдена.
+type NotFoundError struct {
+	Entity string
+	Field  string
+	Value  any
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("%s with %s %v not found", e.Entity, e.Field, e.Value)
+}
+
+func (e *NotFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
+// ConflictError — запись Entity нарушает ограничение уникальности Constraint.
+type ConflictError struct {
+	Entity     string
+	Constraint string
+	Fields     []string
+}
+
+func (e *ConflictError) Error() string {
+	if len(e.Fields) == 0 {
+		return fmt.Sprintf("%s conflicts with an existing record", e.Entity)
+	}
+	return fmt.Sprintf("%s with the same %s already exists", e.Entity, strings.Join(e.Fields, ", "))
+}
+
+func (e *ConflictError) Is(target error) bool {
+	return target == ErrConflict
+}
+
+// ValidationError перечисляет поля записи Entity, значения которых нельзя сохранить.
+type ValidationError struct {
+	Entity string
+	Fields []models.FieldError
+}
+
+func (e *ValidationError) Error() string {
+	parts := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		parts[i] = f.Field + ": " + f.Message
+	}
+	return fmt.Sprintf("invalid %s: %s", e.Entity, strings.Join(parts, "; "))
+}
+
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrValidation
+}
+
+// invalid возвращает ValidationError, если в fields есть ошибки, и nil иначе.
+func invalid(entity string, fields []models.FieldError) error {
+	if len(fields) == 0 {
+		return nil
+	}
+	return &ValidationError{Entity: entity, Fields: fields}
+}
+
+// keyColumns достаёт имена столбцов из сообщения PostgreSQL вида "Key (song_id, verse_number)=(1, 2) ...".
+var keyColumns = regexp.MustCompile(`^Key \(([^)]+)\)=`)
+
+// translate переводит ошибки GORM и PostgreSQL в ошибки репозитория. Отсутствующая запись
+// описывается как запись entity с field, равным value. Остальные ошибки возвращаются как есть.
+func translate(err error, entity, field string, value any) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &NotFoundError{Entity: entity, Field: field, Value: value}
+	}
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	var columns []string
+	if m := keyColumns.FindStringSubmatch(pgErr.Detail); m != nil {
+		columns = strings.Split(m[1], ", ")
+	} else if pgErr.ColumnName != "" {
+		columns = []string{pgErr.ColumnName}
+	}
+	violation := func(code, message string) error {
+		fields := make([]models.FieldError, 0, max(len(columns), 1))
+		for _, column := range columns {
+			fields = append(fields, models.FieldError{Field: column, Code: code, Message: message})
+		}
+		if len(fields) == 0 {
+			fields = append(fields, models.FieldError{Field: pgErr.ConstraintName, Code: code, Message: message})
+		}
+		return &ValidationError{Entity: entity, Fields: fields}
+	}
+
+	switch pgErr.Code {
+	case pgUniqueViolation:
+		return &ConflictError{Entity: entity, Constraint: pgErr.ConstraintName, Fields: columns}
+	case pgForeignKeyViolation:
+		return violation(models.CodeNotFound, "references a record that does not exist")
+	case pgNotNullViolation:
+		return violation(models.CodeRequired, "must not be empty")
+	case pgCheckViolation:
+		return violation(models.CodeInvalid, "violates constraint "+pgErr.ConstraintName)
+	case pgStringTooLong:
+		return violation(models.CodeTooLong, "value is too long")
+	}
+	return err
+}
@@ -0,0 +1,28 @@
+package postgres
+
+import (
+	"Music_Library/internal/events"
+	"Music_Library/internal/models"
+	"context"
+)
+
+// publishSong сообщает подписчикам об изменении песни.
+func publishSong(ctx context.Context, t events.Type, song *models.Song) {
+	e := events.Event{Type: t, SongID: song.ID, Group: song.Group}
+	if t != events.SongDeleted {
+		e.Data = song
+	}
+	publish(ctx, e)
+}
+
+// publishLyric сообщает подписчикам об изменении куплета.
+func publishLyric(ctx context.Context, t events.Type, lyric *models.Lyric) {
+	var group string
+	conn(ctx).Model(&models.Song{}).Where("id = ?", lyric.SongID).Limit(1).Pluck("group", &group)
+
+	e := events.Event{Type: t, SongID: lyric.SongID, LyricID: lyric.ID, Group: group}
+	if t != events.LyricDeleted {
+		e.Data = lyric
+	}
+	publish(ctx, e)
+}
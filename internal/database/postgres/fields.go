@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"Music_Library/internal/models"
+	"fmt"
+	"gorm.io/gorm"
+	"strings"
+)
+
+// songFieldColumns — поля песни, которые можно запросить параметром fields, и их столбцы.
+// ID читается всегда: без него не загрузить куплеты и обложки.
+var songFieldColumns = map[string][]string{
+	"id":           nil,
+	"group":        {`"group"`},
+	"title":        {"title"},
+	"release_date": {"released_on", "release_precision"},
+	"link":         {"link"},
+}
+
+// SongFields — какие поля песни читать из базы и какие связи загружать.
+type SongFields struct {
+	// Fields — имена полей песни из параметра fields. Пустой список — все поля.
+	Fields  []string
+	Lyrics  bool
+	Artwork bool
+}
+
+// AllSongFields читает песню целиком вместе с куплетами и обложками.
+var AllSongFields = SongFields{Lyrics: true, Artwork: true}
+
+// ParseSongFields разбирает параметры fields и include: имена через запятую.
+// В include допустимы lyrics и artwork.
+func ParseSongFields(fields, include string) (SongFields, error) {
+	var (
+		result   SongFields
+		problems []models.FieldError
+	)
+	for _, name := range splitList(fields) {
+		if _, ok := songFieldColumns[name]; !ok {
+			problems = append(problems, models.FieldError{Field: "fields", Code: models.CodeInvalid,
+				Message: fmt.Sprintf("unknown field %q, must be one of group, id, link, release_date, title", name)})
+			continue
+		}
+		result.Fields = append(result.Fields, name)
+	}
+	for _, name := range splitList(include) {
+		switch name {
+		case "lyrics":
+			result.Lyrics = true
+		case "artwork":
+			result.Artwork = true
+		default:
+			problems = append(problems, models.FieldError{Field: "include", Code: models.CodeInvalid,
+				Message: fmt.Sprintf("unknown relation %q, must be lyrics or artwork", name)})
+		}
+	}
+	if err := invalid("song fields", problems); err != nil {
+		return SongFields{}, err
+	}
+	return result, nil
+}
+
+// splitList разбивает список через запятую, пропуская пустые элементы.
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// apply ограничивает SELECT запрошенными столбцами и загружает запрошенные связи.
+func (f SongFields) apply(query *gorm.DB) *gorm.DB {
+	if len(f.Fields) > 0 {
+		columns := []string{"songs.id"}
+		for _, name := range f.Fields {
+			for _, column := range songFieldColumns[name] {
+				columns = append(columns, "songs."+column)
+			}
+		}
+		query = query.Select(columns)
+	}
+	if f.Lyrics {
+		query = query.Preload("Lyrics", orderVerses)
+	}
+	if f.Artwork {
+		query = query.Preload("Artwork")
+	}
+	return query
+}
+
+// orderVerses упорядочивает загружаемые куплеты по номеру.
+func orderVerses(db *gorm.DB) *gorm.DB {
+	return db.Order("verse_number, id")
+}
@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"Music_Library/internal/models"
+	"fmt"
+	"gorm.io/gorm"
+	"time"
+)
+
+// MaxFilterIDs — сколько ID песен можно перечислить в одном фильтре.
+const MaxFilterIDs = 100
+
+// SongFilter — условия отбора песен. Пустые поля выборку не ограничивают.
+type SongFilter struct {
+	// IDs отбирает песни с перечисленными ID.
+	IDs   []uint
+	Group string
+	Title string
+	Link  string
+	// ReleaseDate совпадает с датой выхода вместе с точностью: "1997" не найдёт песню от 1997-07-16.
+	ReleaseDate models.ReleaseDate
+	// ReleasedAfter и ReleasedBefore включают границы и учитывают их точность:
+	// ReleasedAfter "1990" и ReleasedBefore "1999" отбирают песни с 1990-01-01 по 1999-12-31.
+	ReleasedAfter  models.ReleaseDate
+	ReleasedBefore models.ReleaseDate
+	// Year отбирает песни, вышедшие в этом году, с любой точностью даты; nil — без отбора по году.
+	Year *int
+}
+
+// validate проверяет даты и год фильтра. Поля называются так же, как параметры GET /songs.
+func (f SongFilter) validate() error {
+	var fields []models.FieldError
+	dates := []struct {
+		name string
+		date models.ReleaseDate
+	}{{"release_date", f.ReleaseDate}, {"released_after", f.ReleasedAfter}, {"released_before", f.ReleasedBefore}}
+	for _, d := range dates {
+		if !d.date.Valid() {
+			fields = append(fields, models.FieldError{Field: d.name, Code: models.CodeInvalid, Message: models.InvalidReleaseDate})
+		}
+	}
+	if len(f.IDs) > MaxFilterIDs {
+		fields = append(fields, models.FieldError{Field: "ids", Code: models.CodeInvalid, Message: fmt.Sprintf("must list at most %d IDs", MaxFilterIDs)})
+	}
+	if f.Year != nil && (*f.Year < 1 || *f.Year > 9999) {
+		fields = append(fields, models.FieldError{Field: "year", Code: models.CodeInvalid, Message: "must be between 1 and 9999"})
+	}
+	return invalid("song filter", fields)
+}
+
+func (f SongFilter) apply(query *gorm.DB) *gorm.DB {
+	if len(f.IDs) > 0 {
+		query = query.Where("songs.id IN ?", f.IDs)
+	}
+	if f.Group != "" {
+		query = query.Where(`"group" = ?`, f.Group)
+	}
+	if f.Title != "" {
+		query = query.Where("title = ?", f.Title)
+	}
+	if f.Link != "" {
+		query = query.Where("link = ?", f.Link)
+	}
+	if !f.ReleaseDate.IsZero() {
+		query = query.Where("released_on = ? AND release_precision = ?", date(f.ReleaseDate.Start()), f.ReleaseDate.Precision)
+	}
+	if !f.ReleasedAfter.IsZero() {
+		query = query.Where("released_on >= ?", date(f.ReleasedAfter.Start()))
+	}
+	if !f.ReleasedBefore.IsZero() {
+		query = query.Where("released_on <= ?", date(f.ReleasedBefore.End()))
+	}
+	if f.Year != nil {
+		year := models.Year(*f.Year)
+		query = query.Where("released_on BETWEEN ? AND ?", date(year.Start()), date(year.End()))
+	}
+	return query
+}
+
+// date передаёт в запрос только дату, чтобы сравнение со столбцом типа date не зависело от часового пояса.
+func date(t time.Time) string {
+	return t.Format(time.DateOnly)
+}
@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+)
+
+// Problem описывает нарушение целостности данных, найденное CheckIntegrity.
+type Problem struct {
+	Kind   string `json:"kind"`
+	Table  string `json:"table"`
+	ID     uint   `json:"id"`
+	Detail string `json:"detail"`
+}
+
+// BlobRef — ссылка строки базы данных на файл в хранилище.
+type BlobRef struct {
+	Table string
+	ID    uint
+	Key   string
+}
+
+type integrityCheck struct {
+	kind  string
+	table string
+	query string
+}
+
+// Каждый запрос возвращает id проблемной строки и пояснение.
+var integrityChecks = []integrityCheck{
+	{"orphan_lyric", "lyrics",
+		`SELECT l.id, 'song ' || l.song_id || ' does not exist' AS detail FROM lyrics l
+		 LEFT JOIN songs s ON s.id = l.song_id WHERE s.id IS NULL`},
+	{"orphan_audio", "audio_files",
+		`SELECT a.id, 'song ' || a.song_id || ' does not exist' AS detail FROM audio_files a
+		 LEFT JOIN songs s ON s.id = a.song_id WHERE s.id IS NULL`},
+	{"orphan_artwork", "artworks",
+		`SELECT a.id, 'song ' || a.song_id || ' does not exist' AS detail FROM artworks a
+		 LEFT JOIN songs s ON s.id = a.song_id WHERE s.id IS NULL`},
+	{"invalid_verse_number", "lyrics",
+		`SELECT id, 'verse number ' || verse_number || ' is not positive' AS detail FROM lyrics WHERE verse_number <= 0`},
+	{"empty_field", "songs",
+		`SELECT id, 'group or title is empty' AS detail FROM songs WHERE TRIM("group") = '' OR TRIM(title) = ''`},
+	{"empty_field", "lyrics",
+		`SELECT id, 'text is empty' AS detail FROM lyrics WHERE TRIM(text) = ''`},
+	{"duplicate_song", "songs",
+		`SELECT s.id, 'song with the same group and title exists' AS detail FROM songs s
+		 JOIN (SELECT "group", title FROM songs GROUP BY "group", title HAVING COUNT(*) > 1) d
+		 ON d."group" = s."group" AND d.title = s.title`},
+}
+
+// CheckIntegrity ищет строки, нарушающие связи и ограничения, которые база данных сама не проверяет.
+func CheckIntegrity(ctx context.Context) ([]Problem, error) {
+	ctx, span := startSpan(ctx, "CheckIntegrity")
+	defer span.End()
+
+	var problems []Problem
+	for _, check := range integrityChecks {
+		var rows []struct {
+			ID     uint
+			Detail string
+		}
+		if err := DB.WithContext(ctx).Raw(check.query + " ORDER BY 1").Scan(&rows).Error; err != nil {
+			return nil, fmt.Errorf("check %s: %w", check.kind, err)
+		}
+		for _, row := range rows {
+			problems = append(problems, Problem{Kind: check.kind, Table: check.table, ID: row.ID, Detail: row.Detail})
+		}
+	}
+	return problems, nil
+}
+
+// GetBlobRefs возвращает все ссылки на файлы в хранилище: аудиофайлы и изображения обложек.
+func GetBlobRefs(ctx context.Context) ([]BlobRef, error) {
+	ctx, span := startSpan(ctx, "GetBlobRefs")
+	defer span.End()
+
+	var refs []BlobRef
+	result := DB.WithContext(ctx).Raw(`SELECT 'audio_files' AS "table", id, checksum AS key FROM audio_files
+		UNION ALL SELECT 'artworks', id, key FROM artworks ORDER BY 1, 2`).Scan(&refs)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return refs, nil
+}
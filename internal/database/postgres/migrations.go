@@ -0,0 +1,131 @@
+package postgres
+
+import (
+	"Music_Library/internal/models"
+	"context"
+	"fmt"
+	"gorm.io/gorm"
+	"time"
+)
+
+// schemaMigration — запись о применённой миграции данных.
+type schemaMigration struct {
+	Version   int `gorm:"primaryKey;autoIncrement:false"`
+	Name      string
+	AppliedAt time.Time
+}
+
+func (schemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+type migration struct {
+	version int
+	name    string
+	up      func(tx *gorm.DB) error
+}
+
+// migrations перечисляет изменения данных, которые AutoMigrate сделать не может, в порядке версий.
+// Новая миграция добавляется в конец со следующим номером.
+var migrations = []migration{
+	{1, "initial schema", nil},
+	{2, "release dates as dates", migrateReleaseDates},
+	{3, "unique verse numbers", migrateUniqueVerses},
+}
+
+// LatestVersion — версия схемы, которую ожидает этот код.
+func LatestVersion() int {
+	return migrations[len(migrations)-1].version
+}
+
+// Migrate создаёт и обновляет таблицы всех моделей и применяет ещё не применённые миграции данных.
+// Каждая миграция выполняется в своей транзакции вместе с записью о ней.
+func Migrate() error {
+	err := DB.AutoMigrate(&models.Song{}, &models.Lyric{}, &models.AudioFile{}, &models.Artwork{},
+		&models.Webhook{}, &models.WebhookDelivery{}, &schemaMigration{})
+	if err != nil {
+		return err
+	}
+
+	current, err := SchemaVersion(context.Background())
+	if err != nil {
+		return err
+	}
+	for _, m := range migrations {
+		if m.version <= current {
+			continue
+		}
+		err = DB.Transaction(func(tx *gorm.DB) error {
+			if m.up != nil {
+				if err := m.up(tx); err != nil {
+					return err
+				}
+			}
+			return tx.Create(&schemaMigration{Version: m.version, Name: m.name, AppliedAt: time.Now()}).Error
+		})
+		if err != nil {
+			return fmt.Errorf("migration %d (%s): %w", m.version, m.name, err)
+		}
+	}
+	return nil
+}
+
+// migrateReleaseDates переносит даты выхода из старого текстового столбца release_date
+// ("16.07.2006", "2006-07-16", "1997") в released_on и release_precision. Если все даты
+// разобраны, старый столбец удаляется, иначе переименовывается в release_date_legacy,
+// чтобы неразобранные значения можно было исправить вручную.
+func migrateReleaseDates(tx *gorm.DB) error {
+	if !tx.Migrator().HasColumn("songs", "release_date") {
+		return nil
+	}
+	var rows []struct {
+		ID          uint
+		ReleaseDate string
+	}
+	err := tx.Table("songs").Select("id, release_date").
+		Where("release_date IS NOT NULL AND TRIM(release_date) <> ''").Scan(&rows).Error
+	if err != nil {
+		return err
+	}
+
+	unparsed := 0
+	for _, row := range rows {
+		date, err := models.ParseReleaseDate(row.ReleaseDate)
+		if err != nil {
+			unparsed++
+			continue
+		}
+		err = tx.Table("songs").Where("id = ?", row.ID).Updates(map[string]any{
+			"released_on":       date.Start().Format(time.DateOnly),
+			"release_precision": date.Precision,
+		}).Error
+		if err != nil {
+			return err
+		}
+	}
+	if unparsed > 0 {
+		return tx.Migrator().RenameColumn("songs", "release_date", "release_date_legacy")
+	}
+	return tx.Migrator().DropColumn("songs", "release_date")
+}
+
+// migrateUniqueVerses нумерует заново куплеты песен, в которых номера повторяются, сохраняя
+// их порядок (при равных номерах — по ID), и создаёт уникальный индекс по песне и номеру куплета.
+// Индекс не описан в модели, потому что AutoMigrate создал бы его раньше, чем повторы будут устранены.
+func migrateUniqueVerses(tx *gorm.DB) error {
+	err := tx.Exec(`UPDATE lyrics l SET verse_number = r.n FROM (
+		SELECT id, ROW_NUMBER() OVER (PARTITION BY song_id ORDER BY verse_number, id) AS n FROM lyrics
+		WHERE song_id IN (SELECT song_id FROM lyrics GROUP BY song_id, verse_number HAVING COUNT(*) > 1)
+	) r WHERE l.id = r.id AND l.verse_number <> r.n`).Error
+	if err != nil {
+		return err
+	}
+	return tx.Exec("CREATE UNIQUE INDEX IF NOT EXISTS idx_lyrics_song_verse ON lyrics (song_id, verse_number)").Error
+}
+
+// SchemaVersion возвращает номер последней применённой миграции или 0, если миграций ещё не было.
+func SchemaVersion(ctx context.Context) (int, error) {
+	var version int
+	result := DB.WithContext(ctx).Model(&schemaMigration{}).Select("COALESCE(MAX(version), 0)").Scan(&version)
+	return version, result.Error
+}
@@ -1,55 +1,69 @@
 package postgres
 
 import (
+	"Music_Library/internal/events"
 	"Music_Library/internal/models"
-	"errors"
+	"context"
+	"fmt"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"strconv"
+	"strings"
 )
 
-// GetSong возвращает песню по её ID.
-func GetSong(id uint) (*models.Song, error) {
+// GetSong возвращает песню по её ID вместе с куплетами и обложками.
+func GetSong(ctx context.Context, id uint) (*models.Song, error) {
+	return GetSongWithFields(ctx, id, AllSongFields)
+}
+
+// GetSongWithFields возвращает песню по её ID, читая только поля и связи из fields.
+func GetSongWithFields(ctx context.Context, id uint, fields SongFields) (*models.Song, error) {
+	ctx, span := startSpan(ctx, "GetSong")
+	defer span.End()
+
 	var song models.Song
-	query := DB.Model(&models.Song{}).Preload("Lyrics")
+	query := fields.apply(conn(ctx).Model(&models.Song{}))
 	result := query.First(&song, id)
 	if result.Error != nil {
-		return nil, result.Error
+		return nil, translate(result.Error, "song", "id", id)
 	}
 	return &song, nil
 }
 
 // AddSong добавляет новую песню в базу данных.
-func AddSong(song *models.Song) error {
-	result := DB.Create(song)
-	if result.Error != nil {
-		return result.Error
-	}
-	for lyric := range song.Lyrics {
-		DB.Create(lyric)
+func AddSong(ctx context.Context, song *models.Song) error {
+	ctx, span := startSpan(ctx, "AddSong")
+	defer span.End()
+
+	if err := invalid("song", song.Validate()); err != nil {
+		return err
 	}
-	return nil
+	return InTransaction(ctx, func(ctx context.Context) error {
+		// Куплеты сохраняются вместе с песней как её связь.
+		if err := conn(ctx).Omit("Artwork").Create(song).Error; err != nil {
+			return translate(err, "song", "id", song.ID)
+		}
+		publishSong(ctx, events.SongCreated, song)
+		return nil
+	})
 }
 
-// GetAllSongs возвращает список песен с фильтрацией и пагинацией.
-func GetAllSongs(group, title, releaseDate, link string, page, pageSize int) ([]models.Song, int64, error) {
-	var songs []models.Song
-	query := DB.Model(&models.Song{}).Preload("Lyrics")
+// GetAllSongs возвращает список песен с фильтрацией, сортировкой и пагинацией.
+// Из базы читаются только поля и связи из fields.
+func GetAllSongs(ctx context.Context, filter SongFilter, order SongSort, fields SongFields, page, pageSize int) ([]models.Song, int64, error) {
+	ctx, span := startSpan(ctx, "GetAllSongs")
+	defer span.End()
 
-	if group != "" {
-		query = query.Where(`"group" = ?`, group)
-	}
-	if title != "" {
-		query = query.Where("title = ?", title)
-	}
-	if releaseDate != "" {
-		query = query.Where("release_date = ?", releaseDate)
-	}
-	if link != "" {
-		query = query.Where("link = ?", link)
+	if err := filter.validate(); err != nil {
+		return nil, 0, err
 	}
+	var songs []models.Song
+	query := filter.apply(conn(ctx).Model(&models.Song{}))
 
 	var total int64
 	query.Count(&total)
 
-	query = query.Offset(page).Limit(pageSize)
+	query = fields.apply(order.apply(query)).Offset(page).Limit(pageSize)
 
 	result := query.Find(&songs)
 	if result.Error != nil {
@@ -60,74 +74,729 @@ func GetAllSongs(group, title, releaseDate, link string, page, pageSize int) ([]
 }
 
 // UpdateSong обновляет данные песни.
-func UpdateSong(id uint, updatedSong *models.Song) (*models.Song, error) {
-	result := DB.Model(&models.Song{}).Where("id = ?", id).Updates(updatedSong)
-	if result.Error != nil {
-		return nil, result.Error
+func UpdateSong(ctx context.Context, id uint, updatedSong *models.Song) (*models.Song, error) {
+	ctx, span := startSpan(ctx, "UpdateSong")
+	defer span.End()
+
+	if err := invalid("song", updatedSong.ValidatePartial()); err != nil {
+		return nil, err
 	}
-	if result.RowsAffected == 0 {
-		return nil, errors.New("record not found")
+	var song *models.Song
+	err := InTransaction(ctx, func(ctx context.Context) error {
+		result := conn(ctx).Model(&models.Song{}).Omit("Artwork").Where("id = ?", id).Updates(updatedSong)
+		if result.Error != nil {
+			return translate(result.Error, "song", "id", id)
+		}
+		if result.RowsAffected == 0 {
+			return &NotFoundError{Entity: "song", Field: "id", Value: id}
+		}
+		var err error
+		if song, err = GetSong(ctx, id); err != nil {
+			return err
+		}
+		publishSong(ctx, events.SongUpdated, song)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	song, err := GetSong(id)
-	return song, err
+	return song, nil
+}
+
+// ReplaceSong заменяет песню целиком: незаполненные поля очищаются, а куплеты
+// приводятся к переданным, как в PatchSong.
+func ReplaceSong(ctx context.Context, id uint, song *models.Song) (*models.Song, error) {
+	return PatchSong(ctx, id, func(current *models.Song) error {
+		*current = *song
+		return nil
+	})
 }
 
-// DeleteSong удаляет песню по её ID.
-func DeleteSong(id uint) error {
-	if err := DB.Where("song_id = ?", id).Delete(&models.Lyric{}).Error; err != nil {
-		return errors.New("failed to delete lyrics")
+// PatchSong читает песню с куплетами под блокировкой строки, передаёт её в patch и сохраняет
+// результат целиком, включая пустые значения. Куплеты с ID обновляются, без ID добавляются,
+// а куплеты, которых в результате нет, удаляются.
+func PatchSong(ctx context.Context, id uint, patch func(*models.Song) error) (*models.Song, error) {
+	ctx, span := startSpan(ctx, "PatchSong")
+	defer span.End()
+
+	var patched *models.Song
+	err := InTransaction(ctx, func(ctx context.Context) error {
+		tx := conn(ctx)
+		var song models.Song
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Preload("Lyrics", orderVerses).First(&song, id).Error
+		if err != nil {
+			return translate(err, "song", "id", id)
+		}
+		if err = patch(&song); err != nil {
+			return err
+		}
+		song.ID = id
+		if err = invalid("song", song.Validate()); err != nil {
+			return err
+		}
+
+		err = tx.Model(&models.Song{ID: id}).Updates(map[string]any{
+			"group":             song.Group,
+			"title":             song.Title,
+			"released_on":       song.ReleaseDate.Time,
+			"release_precision": song.ReleaseDate.Precision,
+			"link":              song.Link,
+		}).Error
+		if err != nil {
+			return translate(err, "song", "id", id)
+		}
+		if err = replaceLyrics(tx, id, song.Lyrics); err != nil {
+			return err
+		}
+		if patched, err = GetSong(ctx, id); err != nil {
+			return err
+		}
+		publishSong(ctx, events.SongUpdated, patched)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	result := DB.Delete(&models.Song{}, id)
-	if result.Error != nil {
-		return result.Error
+	return patched, nil
+}
+
+// replaceLyrics приводит куплеты песни к lyrics. Куплет с ID должен принадлежать этой песне.
+func replaceLyrics(tx *gorm.DB, songID uint, lyrics []models.Lyric) error {
+	var keep []uint
+	for i := range lyrics {
+		lyrics[i].SongID = songID
+		if lyrics[i].ID != 0 {
+			keep = append(keep, lyrics[i].ID)
+		}
 	}
-	if result.RowsAffected == 0 {
-		return errors.New("record not found")
+	stale := tx.Where("song_id = ?", songID)
+	if len(keep) > 0 {
+		stale = stale.Where("id NOT IN ?", keep)
 	}
-	return nil
+	if err := stale.Delete(&models.Lyric{}).Error; err != nil {
+		return err
+	}
+	// Оставшиеся куплеты временно получают отрицательные номера, чтобы они могли обменяться
+	// номерами, не нарушая уникальный индекс на промежуточных шагах.
+	err := tx.Model(&models.Lyric{}).Where("song_id = ?", songID).Update("verse_number", gorm.Expr("-verse_number")).Error
+	if err != nil {
+		return err
+	}
+
+	var fields []models.FieldError
+	for i := range lyrics {
+		lyric := &lyrics[i]
+		if lyric.ID == 0 {
+			if err := tx.Create(lyric).Error; err != nil {
+				return translate(err, "lyric", "id", lyric.ID)
+			}
+			continue
+		}
+		result := tx.Model(&models.Lyric{}).Where("id = ? AND song_id = ?", lyric.ID, songID).
+			Updates(map[string]any{"verse_number": lyric.VerseNumber, "text": lyric.Text})
+		if result.Error != nil {
+			return translate(result.Error, "lyric", "id", lyric.ID)
+		}
+		if result.RowsAffected == 0 {
+			fields = append(fields, models.FieldError{Field: fmt.Sprintf("lyrics[%d].ID", i), Code: models.CodeNotFound,
+				Message: fmt.Sprintf("lyric %d does not belong to song %d", lyric.ID, songID)})
+		}
+	}
+	return invalid("song", fields)
+}
+
+// DeleteSong удаляет песню по её ID вместе с куплетами, аудиофайлом и обложками в одной транзакции.
+// Файлы, на которые больше никто не ссылается, удаляются из хранилища после её фиксации.
+func DeleteSong(ctx context.Context, id uint) error {
+	ctx, span := startSpan(ctx, "DeleteSong")
+	defer span.End()
+
+	return InTransaction(ctx, func(ctx context.Context) error {
+		if err := conn(ctx).Where("song_id = ?", id).Delete(&models.Lyric{}).Error; err != nil {
+			return translate(err, "lyric", "song_id", id)
+		}
+		var audio []models.AudioFile
+		if err := conn(ctx).Clauses(clause.Returning{}).Where("song_id = ?", id).Delete(&audio).Error; err != nil {
+			return translate(err, "audio file", "song_id", id)
+		}
+		var artwork []models.Artwork
+		if err := conn(ctx).Clauses(clause.Returning{}).Where("song_id = ?", id).Delete(&artwork).Error; err != nil {
+			return translate(err, "artwork", "song_id", id)
+		}
+		var song models.Song
+		result := conn(ctx).Clauses(clause.Returning{}).Delete(&song, id)
+		if result.Error != nil {
+			return translate(result.Error, "song", "id", id)
+		}
+		if result.RowsAffected == 0 {
+			return &NotFoundError{Entity: "song", Field: "id", Value: id}
+		}
+		publishSong(ctx, events.SongDeleted, &song)
+		afterCommit(ctx, func(ctx context.Context) {
+			for _, a := range audio {
+				ReleaseAudio(ctx, a.Checksum)
+			}
+			keys := make([]string, len(artwork))
+			for i, a := range artwork {
+				keys[i] = a.Key
+			}
+			ReleaseArtwork(ctx, keys)
+		})
+		return nil
+	})
 }
 
 // GetLyric возвращает куплет по его ID
-func GetLyric(id uint) (*models.Lyric, error) {
+func GetLyric(ctx context.Context, id uint) (*models.Lyric, error) {
+	ctx, span := startSpan(ctx, "GetLyric")
+	defer span.End()
+
 	lyric := &models.Lyric{}
-	result := DB.First(&lyric, id)
+	result := conn(ctx).First(&lyric, id)
 	if result.Error != nil {
-		return nil, result.Error
+		return nil, translate(result.Error, "lyric", "id", id)
 	}
 	return lyric, nil
 }
 
 // AddLyric добавляет новый куплет
-func AddLyric(lyric *models.Lyric) error {
-	result := DB.Create(lyric)
-	if result.Error != nil {
-		return result.Error
+func AddLyric(ctx context.Context, lyric *models.Lyric) error {
+	ctx, span := startSpan(ctx, "AddLyric")
+	defer span.End()
+
+	if err := validateLyric(ctx, lyric); err != nil {
+		return err
 	}
-	return nil
+	return InTransaction(ctx, func(ctx context.Context) error {
+		if err := conn(ctx).Create(lyric).Error; err != nil {
+			return translate(err, "lyric", "id", lyric.ID)
+		}
+		publishLyric(ctx, events.LyricCreated, lyric)
+		return nil
+	})
 }
 
 // UpdateLyric обновляет куплет по id
-func UpdateLyric(id uint, updateLyric *models.Lyric) (*models.Lyric, error) {
-	result := DB.Model(&models.Lyric{}).Where("id = ?", id).Updates(updateLyric)
+func UpdateLyric(ctx context.Context, id uint, updateLyric *models.Lyric) (*models.Lyric, error) {
+	ctx, span := startSpan(ctx, "UpdateLyric")
+	defer span.End()
+
+	fields := updateLyric.ValidatePartial()
+	if updateLyric.SongID != 0 {
+		violation, err := checkSong(ctx, updateLyric.SongID)
+		if err != nil {
+			return nil, err
+		}
+		if violation != nil {
+			fields = append(fields, *violation)
+		}
+	}
+	if err := invalid("lyric", fields); err != nil {
+		return nil, err
+	}
+	var lyric *models.Lyric
+	err := InTransaction(ctx, func(ctx context.Context) error {
+		result := conn(ctx).Model(&models.Lyric{}).Where("id = ?", id).Updates(updateLyric)
+		if result.Error != nil {
+			return translate(result.Error, "lyric", "id", id)
+		}
+		if result.RowsAffected == 0 {
+			return &NotFoundError{Entity: "lyric", Field: "id", Value: id}
+		}
+		var err error
+		if lyric, err = GetLyric(ctx, id); err != nil {
+			return err
+		}
+		publishLyric(ctx, events.LyricUpdated, lyric)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return lyric, nil
+}
+
+// ReplaceLyric заменяет куплет целиком, как PatchLyric.
+func ReplaceLyric(ctx context.Context, id uint, lyric *models.Lyric) (*models.Lyric, error) {
+	return PatchLyric(ctx, id, func(current *models.Lyric) error {
+		*current = *lyric
+		return nil
+	})
+}
+
+// PatchLyric читает куплет под блокировкой строки, передаёт его в patch и сохраняет
+// результат целиком, включая пустые значения.
+func PatchLyric(ctx context.Context, id uint, patch func(*models.Lyric) error) (*models.Lyric, error) {
+	ctx, span := startSpan(ctx, "PatchLyric")
+	defer span.End()
+
+	var lyric models.Lyric
+	err := InTransaction(ctx, func(ctx context.Context) error {
+		tx := conn(ctx)
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&lyric, id).Error
+		if err != nil {
+			return translate(err, "lyric", "id", id)
+		}
+		if err = patch(&lyric); err != nil {
+			return err
+		}
+		lyric.ID = id
+		if err = validateLyric(ctx, &lyric); err != nil {
+			return err
+		}
+		err = tx.Model(&models.Lyric{ID: id}).Updates(map[string]any{
+			"song_id":      lyric.SongID,
+			"verse_number": lyric.VerseNumber,
+			"text":         lyric.Text,
+		}).Error
+		if err != nil {
+			return translate(err, "lyric", "id", id)
+		}
+		publishLyric(ctx, events.LyricUpdated, &lyric)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &lyric, nil
+}
+
+// DeleteLyric удаляет куплет по ID
+func DeleteLyric(ctx context.Context, id uint) error {
+	ctx, span := startSpan(ctx, "DeleteLyric")
+	defer span.End()
+
+	return InTransaction(ctx, func(ctx context.Context) error {
+		var lyric models.Lyric
+		result := conn(ctx).Clauses(clause.Returning{}).Delete(&lyric, id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return &NotFoundError{Entity: "lyric", Field: "id", Value: id}
+		}
+		publishLyric(ctx, events.LyricDeleted, &lyric)
+		return nil
+	})
+}
+
+// checkSong проверяет, что куплет ссылается на существующую песню.
+func checkSong(ctx context.Context, songID uint) (*models.FieldError, error) {
+	var count int64
+	if err := conn(ctx).Model(&models.Song{}).Where("id = ?", songID).Count(&count).Error; err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		return &models.FieldError{Field: "song_id", Code: models.CodeNotFound, Message: fmt.Sprintf("song %d does not exist", songID)}, nil
+	}
+	return nil, nil
+}
+
+// songExists возвращает NotFoundError, если песни с таким ID нет.
+func songExists(ctx context.Context, id uint) error {
+	violation, err := checkSong(ctx, id)
+	if err != nil {
+		return err
+	}
+	if violation != nil {
+		return &NotFoundError{Entity: "song", Field: "id", Value: id}
+	}
+	return nil
+}
+
+// validateLyric проверяет все поля куплета и существование его песни.
+func validateLyric(ctx context.Context, lyric *models.Lyric) error {
+	fields := lyric.Validate()
+	if lyric.SongID == 0 {
+		fields = append(fields, models.FieldError{Field: "song_id", Code: models.CodeRequired, Message: "is required"})
+	} else if violation, err := checkSong(ctx, lyric.SongID); err != nil {
+		return err
+	} else if violation != nil {
+		fields = append(fields, *violation)
+	}
+	return invalid("lyric", fields)
+}
+
+// ImportSong создаёт песню или обновляет уже существующую с той же группой и названием.
+// Заполненные дата выхода и ссылка перезаписываются, а куплеты, если они есть, заменяют сохранённые ранее:
+// об удалённых и добавленных куплетах подписчики узнают, как при их изменении по одному.
+func ImportSong(ctx context.Context, song *models.Song) (bool, error) {
+	ctx, span := startSpan(ctx, "ImportSong")
+	defer span.End()
+
+	if err := invalid("song", song.Validate()); err != nil {
+		return false, err
+	}
+	created := false
+	err := InTransaction(ctx, func(ctx context.Context) error {
+		var existing models.Song
+		result := conn(ctx).Where(`"group" = ? AND title = ?`, song.Group, song.Title).Limit(1).Find(&existing)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			created = true
+			if err := conn(ctx).Omit("Artwork").Create(song).Error; err != nil {
+				return err
+			}
+			publishSong(ctx, events.SongCreated, song)
+			return nil
+		}
+
+		song.ID = existing.ID
+		if err := conn(ctx).Model(&existing).Updates(models.Song{ReleaseDate: song.ReleaseDate, Link: song.Link}).Error; err != nil {
+			return err
+		}
+		if len(song.Lyrics) > 0 {
+			var removed []models.Lyric
+			if err := conn(ctx).Clauses(clause.Returning{}).Where("song_id = ?", existing.ID).Delete(&removed).Error; err != nil {
+				return err
+			}
+			for i := range song.Lyrics {
+				song.Lyrics[i].SongID = existing.ID
+			}
+			if err := conn(ctx).Create(&song.Lyrics).Error; err != nil {
+				return err
+			}
+			for i := range removed {
+				publishLyric(ctx, events.LyricDeleted, &removed[i])
+			}
+			for i := range song.Lyrics {
+				publishLyric(ctx, events.LyricCreated, &song.Lyrics[i])
+			}
+		}
+		publishSong(ctx, events.SongUpdated, song)
+		return nil
+	})
+	return created, translate(err, "song", "id", song.ID)
+}
+
+// GetSongsByIDs возвращает песни с указанными ID одним запросом.
+func GetSongsByIDs(ctx context.Context, ids []uint) ([]models.Song, error) {
+	ctx, span := startSpan(ctx, "GetSongsByIDs")
+	defer span.End()
+
+	var songs []models.Song
+	result := conn(ctx).Where("id IN ?", ids).Order("id").Find(&songs)
 	if result.Error != nil {
 		return nil, result.Error
 	}
-	if result.RowsAffected == 0 {
-		return nil, errors.New("record not found")
+	return songs, nil
+}
+
+// GetSongsByGroups возвращает песни указанных групп одним запросом: у каждой группы
+// пропускается offset первых песен по id и берётся не больше limit следующих.
+func GetSongsByGroups(ctx context.Context, groups []string, offset, limit int) ([]models.Song, error) {
+	ctx, span := startSpan(ctx, "GetSongsByGroups")
+	defer span.End()
+
+	numbered := conn(ctx).Model(&models.Song{}).
+		Select(`*, ROW_NUMBER() OVER (PARTITION BY "group" ORDER BY id) AS n`).
+		Where(`"group" IN ?`, groups)
+	var songs []models.Song
+	result := conn(ctx).Table("(?) AS songs", numbered).
+		Where("n > ? AND n <= ?", offset, offset+limit).
+		Order("id").Find(&songs)
+	if result.Error != nil {
+		return nil, result.Error
 	}
-	lyric, err := GetLyric(id)
-	return lyric, err
+	return songs, nil
+}
+
+// GetLyricsBySongIDs возвращает куплеты нескольких песен одним запросом.
+func GetLyricsBySongIDs(ctx context.Context, songIDs []uint) ([]models.Lyric, error) {
+	ctx, span := startSpan(ctx, "GetLyricsBySongIDs")
+	defer span.End()
 
+	var lyrics []models.Lyric
+	result := conn(ctx).Where("song_id IN ?", songIDs).Order("song_id, verse_number").Find(&lyrics)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return lyrics, nil
 }
 
-// DeleteLyric удаляет куплет по ID
-func DeleteLyric(id uint) error {
-	result := DB.Delete(&models.Lyric{}, id)
+// GetGroups возвращает названия групп по алфавиту с пагинацией.
+func GetGroups(ctx context.Context, offset, limit int) ([]string, int64, error) {
+	ctx, span := startSpan(ctx, "GetGroups")
+	defer span.End()
+
+	var total int64
+	if err := conn(ctx).Model(&models.Song{}).Distinct("group").Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var groups []string
+	result := conn(ctx).Model(&models.Song{}).Distinct("group").Order(`"group"`).Offset(offset).Limit(limit).Pluck("group", &groups)
 	if result.Error != nil {
-		return result.Error
+		return nil, 0, result.Error
 	}
-	if result.RowsAffected == 0 {
-		return errors.New("record not found")
+	return groups, total, nil
+}
+
+// GetLyrics возвращает куплеты с фильтром по песне и пагинацией.
+func GetLyrics(ctx context.Context, songID uint, offset, limit int) ([]models.Lyric, int64, error) {
+	ctx, span := startSpan(ctx, "GetLyrics")
+	defer span.End()
+
+	var lyrics []models.Lyric
+	query := conn(ctx).Model(&models.Lyric{})
+	if songID != 0 {
+		query = query.Where("song_id = ?", songID)
+	}
+
+	var total int64
+	query.Count(&total)
+
+	result := query.Order("song_id, verse_number, id").Offset(offset).Limit(limit).Find(&lyrics)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+	return lyrics, total, nil
+}
+
+// GetSongLyrics возвращает куплеты песни по порядку номеров с пагинацией.
+// Для несуществующей песни возвращает NotFoundError, а не пустой список.
+func GetSongLyrics(ctx context.Context, songID uint, offset, limit int) ([]models.Lyric, int64, error) {
+	ctx, span := startSpan(ctx, "GetSongLyrics")
+	defer span.End()
+
+	if err := songExists(ctx, songID); err != nil {
+		return nil, 0, err
+	}
+	return GetLyrics(ctx, songID, offset, limit)
+}
+
+// GetLyricByVerse возвращает куплет песни с номером verse.
+func GetLyricByVerse(ctx context.Context, songID uint, verse int) (*models.Lyric, error) {
+	ctx, span := startSpan(ctx, "GetLyricByVerse")
+	defer span.End()
+
+	if err := songExists(ctx, songID); err != nil {
+		return nil, err
+	}
+	var lyric models.Lyric
+	result := conn(ctx).Where("song_id = ? AND verse_number = ?", songID, verse).Order("id").First(&lyric)
+	if result.Error != nil {
+		return nil, translate(result.Error, "lyric", "verse_number", verse)
+	}
+	return &lyric, nil
+}
+
+// AddSongLyric добавляет куплет в песню songID. SongID куплета берётся из songID; другой
+// SongID в lyric считается ошибкой. Для несуществующей песни возвращает NotFoundError.
+// С insert занятый номер не приводит к конфликту: куплет вставляется через InsertLyric.
+func AddSongLyric(ctx context.Context, songID uint, lyric *models.Lyric, insert bool) error {
+	if lyric.SongID != 0 && lyric.SongID != songID {
+		return invalid("lyric", []models.FieldError{{Field: "song_id", Code: models.CodeInvalid,
+			Message: fmt.Sprintf("must be empty or %d, the song in the path", songID)}})
+	}
+	if err := songExists(ctx, songID); err != nil {
+		return err
+	}
+	lyric.SongID = songID
+	if insert {
+		return InsertLyric(ctx, lyric)
+	}
+	return AddLyric(ctx, lyric)
+}
+
+// InsertLyric добавляет куплет под номером lyric.VerseNumber, увеличивая на единицу номера
+// куплетов песни, начиная с этого. Сдвинутые куплеты публикуются как обновлённые.
+func InsertLyric(ctx context.Context, lyric *models.Lyric) error {
+	ctx, span := startSpan(ctx, "InsertLyric")
+	defer span.End()
+
+	if err := validateLyric(ctx, lyric); err != nil {
+		return err
+	}
+	return InTransaction(ctx, func(ctx context.Context) error {
+		tx := conn(ctx)
+		if err := lockSong(tx, lyric.SongID); err != nil {
+			return err
+		}
+		result := tx.Model(&models.Lyric{}).Where("song_id = ? AND verse_number >= ?", lyric.SongID, lyric.VerseNumber).
+			Update("verse_number", gorm.Expr("-(verse_number + 1)"))
+		if result.Error != nil {
+			return result.Error
+		}
+		if err := restoreVerses(tx, lyric.SongID); err != nil {
+			return err
+		}
+		if err := tx.Create(lyric).Error; err != nil {
+			return translate(err, "lyric", "id", lyric.ID)
+		}
+		publishLyric(ctx, events.LyricCreated, lyric)
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		var shifted []models.Lyric
+		err := tx.Where("song_id = ? AND verse_number > ?", lyric.SongID, lyric.VerseNumber).
+			Order("verse_number").Find(&shifted).Error
+		if err != nil {
+			return err
+		}
+		for i := range shifted {
+			publishLyric(ctx, events.LyricUpdated, &shifted[i])
+		}
+		return nil
+	})
+}
+
+// ReorderLyrics нумерует куплеты песни songID с единицы в порядке ids. В ids должны быть
+// перечислены все куплеты песни, каждый по одному разу. Возвращает куплеты в новом порядке.
+func ReorderLyrics(ctx context.Context, songID uint, ids []uint) ([]models.Lyric, error) {
+	ctx, span := startSpan(ctx, "ReorderLyrics")
+	defer span.End()
+
+	lyrics := []models.Lyric{}
+	err := InTransaction(ctx, func(ctx context.Context) error {
+		tx := conn(ctx)
+		if err := lockSong(tx, songID); err != nil {
+			return err
+		}
+		var current []models.Lyric
+		if err := tx.Select("id", "verse_number").Where("song_id = ?", songID).Find(&current).Error; err != nil {
+			return err
+		}
+		if err := invalid("lyric order", checkOrder(songID, current, ids)); err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		// Новые номера сначала записываются со знаком минус, чтобы не совпасть с ещё не
+		// перенумерованными куплетами. Номера подставляются числами, чтобы CASE имел целый тип.
+		expr := "CASE id"
+		args := make([]any, len(ids))
+		for i, id := range ids {
+			expr += fmt.Sprintf(" WHEN ? THEN %d", -(i + 1))
+			args[i] = id
+		}
+		err := tx.Model(&models.Lyric{}).Where("song_id = ?", songID).
+			Update("verse_number", gorm.Expr(expr+" END", args...)).Error
+		if err != nil {
+			return err
+		}
+		if err = restoreVerses(tx, songID); err != nil {
+			return err
+		}
+		if err = tx.Where("song_id = ?", songID).Order("verse_number").Find(&lyrics).Error; err != nil {
+			return err
+		}
+
+		before := make(map[uint]int, len(current))
+		for _, lyric := range current {
+			before[lyric.ID] = lyric.VerseNumber
+		}
+		for i := range lyrics {
+			if lyrics[i].VerseNumber != before[lyrics[i].ID] {
+				publishLyric(ctx, events.LyricUpdated, &lyrics[i])
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return lyrics, nil
+}
+
+// checkOrder проверяет, что ids перечисляет все куплеты песни из current по одному разу.
+func checkOrder(songID uint, current []models.Lyric, ids []uint) []models.FieldError {
+	var fields []models.FieldError
+	listed := make(map[uint]bool, len(ids))
+	for _, lyric := range current {
+		listed[lyric.ID] = false
+	}
+	for i, id := range ids {
+		seen, ok := listed[id]
+		switch {
+		case !ok:
+			fields = append(fields, models.FieldError{Field: fmt.Sprintf("ids[%d]", i), Code: models.CodeNotFound,
+				Message: fmt.Sprintf("lyric %d does not belong to song %d", id, songID)})
+		case seen:
+			fields = append(fields, models.FieldError{Field: fmt.Sprintf("ids[%d]", i), Code: models.CodeInvalid,
+				Message: fmt.Sprintf("lyric %d is listed more than once", id)})
+		default:
+			listed[id] = true
+		}
+	}
+	var missing []string
+	for _, lyric := range current {
+		if !listed[lyric.ID] {
+			missing = append(missing, strconv.FormatUint(uint64(lyric.ID), 10))
+		}
+	}
+	if len(missing) > 0 {
+		fields = append(fields, models.FieldError{Field: "ids", Code: models.CodeRequired,
+			Message: "must list every lyric of the song, missing " + strings.Join(missing, ", ")})
+	}
+	return fields
+}
+
+// lockSong блокирует строку песни до конца транзакции tx, чтобы изменения нумерации её
+// куплетов выполнялись по очереди. Для несуществующей песни возвращает NotFoundError.
+func lockSong(tx *gorm.DB, id uint) error {
+	var song models.Song
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&song, id).Error
+	return translate(err, "song", "id", id)
+}
+
+// restoreVerses возвращает положительные номера куплетам песни, временно записанным со знаком минус.
+func restoreVerses(tx *gorm.DB, songID uint) error {
+	return tx.Model(&models.Lyric{}).Where("song_id = ? AND verse_number < 0", songID).
+		Update("verse_number", gorm.Expr("-verse_number")).Error
+}
+
+// EachSong передаёт в fn все песни, подходящие под фильтры, по порядку ID.
+// Песни читаются из базы пачками по batchSize вместе с куплетами.
+func EachSong(ctx context.Context, filter SongFilter, batchSize int, fn func(*models.Song) error) error {
+	ctx, span := startSpan(ctx, "EachSong")
+	defer span.End()
+
+	if err := filter.validate(); err != nil {
+		return err
+	}
+	lastID := uint(0)
+	for {
+		query := filter.apply(conn(ctx).Model(&models.Song{}).Preload("Lyrics").Where("id > ?", lastID))
+
+		var songs []models.Song
+		if err := query.Order("id").Limit(batchSize).Find(&songs).Error; err != nil {
+			return err
+		}
+		for i := range songs {
+			if err := fn(&songs[i]); err != nil {
+				return err
+			}
+		}
+		if len(songs) < batchSize {
+			return nil
+		}
+		lastID = songs[len(songs)-1].ID
+	}
+}
+
+// EachLyric передаёт в fn все куплеты песни (или все куплеты, если songID равен 0)
+// по порядку песен и номеров куплетов.
+func EachLyric(ctx context.Context, songID uint, batchSize int, fn func(*models.Lyric) error) error {
+	ctx, span := startSpan(ctx, "EachLyric")
+	defer span.End()
+
+	for offset := 0; ; offset += batchSize {
+		lyrics, _, err := GetLyrics(ctx, songID, offset, batchSize)
+		if err != nil {
+			return err
+		}
+		for i := range lyrics {
+			if err = fn(&lyrics[i]); err != nil {
+				return err
+			}
+		}
+		if len(lyrics) < batchSize {
+			return nil
+		}
 	}
-	return nil
 }
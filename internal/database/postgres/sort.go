@@ -0,0 +1,100 @@
+package postgres
+
+import (
+	"Music_Library/internal/models"
+	"fmt"
+	"gorm.io/gorm"
+	"sort"
+	"strings"
+)
+
+// songSortColumns — поля, по которым можно сортировать песни, и выражения SQL для них.
+// Только эти выражения попадают в ORDER BY, поэтому параметр sort нельзя использовать для инъекции.
+var songSortColumns = map[string]string{
+	"id":           "songs.id",
+	"group":        `songs."group"`,
+	"title":        "songs.title",
+	"release_date": "songs.released_on",
+	"link":         "songs.link",
+	"lyric_count":  "(SELECT COUNT(*) FROM lyrics WHERE lyrics.song_id = songs.id)",
+}
+
+// SortField — поле сортировки и её направление.
+type SortField struct {
+	Field string
+	Desc  bool
+}
+
+// SongSort — порядок песен. Пустой порядок — по ID.
+type SongSort []SortField
+
+// ParseSongSort разбирает параметр sort вида "-release_date,title": поля через запятую,
+// минус перед полем означает сортировку по убыванию.
+func ParseSongSort(s string) (SongSort, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+	var (
+		order  SongSort
+		fields []models.FieldError
+		seen   = map[string]bool{}
+	)
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		field := SortField{Field: strings.TrimPrefix(part, "-"), Desc: strings.HasPrefix(part, "-")}
+		switch {
+		case songSortColumns[field.Field] == "":
+			fields = append(fields, models.FieldError{Field: "sort", Code: models.CodeInvalid,
+				Message: fmt.Sprintf("unknown field %q, must be one of %s", field.Field, strings.Join(songSortFields(), ", "))})
+		case seen[field.Field]:
+			fields = append(fields, models.FieldError{Field: "sort", Code: models.CodeInvalid,
+				Message: fmt.Sprintf("field %q is repeated", field.Field)})
+		default:
+			seen[field.Field] = true
+			order = append(order, field)
+		}
+	}
+	if err := invalid("song sort", fields); err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+// songSortFields возвращает имена полей, по которым можно сортировать, по алфавиту.
+func songSortFields() []string {
+	names := make([]string, 0, len(songSortColumns))
+	for name := range songSortColumns {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (s SongSort) String() string {
+	parts := make([]string, len(s))
+	for i, f := range s {
+		parts[i] = f.Field
+		if f.Desc {
+			parts[i] = "-" + f.Field
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+// apply добавляет к запросу ORDER BY. Песни без даты выхода идут в конце при любом направлении,
+// а последним всегда сортируется ID, чтобы страницы не пересекались при равных значениях.
+func (s SongSort) apply(query *gorm.DB) *gorm.DB {
+	byID := false
+	for _, f := range s {
+		direction := "ASC"
+		if f.Desc {
+			direction = "DESC"
+		}
+		query = query.Order(songSortColumns[f.Field] + " " + direction + " NULLS LAST")
+		byID = byID || f.Field == "id"
+	}
+	if !byID {
+		query = query.Order("songs.id")
+	}
+	return query
+}
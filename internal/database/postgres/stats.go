@@ -0,0 +1,50 @@
+package postgres
+
+import (
+	"Music_Library/internal/models"
+	"context"
+)
+
+// CatalogStats содержит размеры каталога для метрик.
+type CatalogStats struct {
+	Songs      int64
+	Lyrics     int64
+	AudioFiles int64
+	Artwork    int64
+	Webhooks   int64
+	// Deliveries — число доставок вебхуков по статусам.
+	Deliveries map[string]int64
+}
+
+// GetCatalogStats считает строки основных таблиц одним запросом.
+func GetCatalogStats(ctx context.Context) (*CatalogStats, error) {
+	var stats CatalogStats
+	result := DB.WithContext(ctx).Raw(`SELECT
+		(SELECT COUNT(*) FROM songs) AS songs,
+		(SELECT COUNT(*) FROM lyrics) AS lyrics,
+		(SELECT COUNT(*) FROM audio_files) AS audio_files,
+		(SELECT COUNT(*) FROM artworks) AS artwork,
+		(SELECT COUNT(*) FROM webhooks) AS webhooks`).Scan(&stats)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	var rows []struct {
+		Status string
+		Count  int64
+	}
+	result = DB.WithContext(ctx).Model(&models.WebhookDelivery{}).
+		Select("status, COUNT(*) AS count").Group("status").Scan(&rows)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	stats.Deliveries = map[string]int64{
+		models.DeliveryPending:   0,
+		models.DeliveryDelivered: 0,
+		models.DeliveryFailed:    0,
+	}
+	for _, row := range rows {
+		stats.Deliveries[row.Status] = row.Count
+	}
+	return &stats, nil
+}
@@ -0,0 +1,14 @@
+package postgres
+
+import (
+	"context"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("Music_Library/internal/database/postgres")
+
+// startSpan открывает span функции репозитория. Запросы GORM внутри неё становятся дочерними span'ами.
+func startSpan(ctx context.Context, name string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, "postgres."+name)
+}
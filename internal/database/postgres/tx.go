@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"Music_Library/internal/events"
+	"Music_Library/internal/logging"
+	"context"
+	"gorm.io/gorm"
+	"log/slog"
+	"time"
+)
+
+type txKey struct{}
+
+// txState — транзакция, начатая InTransaction, и события и действия, которые выполнятся после её фиксации.
+type txState struct {
+	db     *gorm.DB
+	events []events.Event
+	hooks  []func(ctx context.Context)
+}
+
+// InTransaction выполняет fn в одной транзакции. Функции репозитория, вызванные с контекстом,
+// который получает fn, работают в этой транзакции, а их события публикуются только после
+// фиксации; при ошибке или панике в fn все изменения откатываются и события не публикуются.
+// Доставки событий вебхукам записываются в очередь в той же транзакции, поэтому зафиксированное
+// изменение не может остаться без них. Вложенный вызов выполняет fn во внешней транзакции.
+func InTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*txState); ok {
+		return fn(ctx)
+	}
+	ctx, span := startSpan(ctx, "InTransaction")
+	defer span.End()
+
+	state := &txState{}
+	err := DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		state.db = tx
+		txCtx := context.WithValue(ctx, txKey{}, state)
+		if err := fn(txCtx); err != nil {
+			return err
+		}
+		return enqueueDeliveries(txCtx, state.events)
+	})
+	if err != nil {
+		return err
+	}
+	for _, e := range state.events {
+		events.Publish(e)
+	}
+	for _, fn := range state.hooks {
+		fn(ctx)
+	}
+	return nil
+}
+
+// conn возвращает подключение для запросов с контекстом ctx: транзакцию InTransaction,
+// если ctx получен из неё, иначе общий пул.
+func conn(ctx context.Context) *gorm.DB {
+	if state, ok := ctx.Value(txKey{}).(*txState); ok {
+		return state.db.WithContext(ctx)
+	}
+	return DB.WithContext(ctx)
+}
+
+// publish передаёт событие подписчикам после фиксации InTransaction. Вне транзакции событие
+// ставится в очередь вебхуков в собственной транзакции и публикуется сразу после неё.
+func publish(ctx context.Context, e events.Event) {
+	if state, ok := ctx.Value(txKey{}).(*txState); ok {
+		e.Time = time.Now().UTC()
+		state.events = append(state.events, e)
+		return
+	}
+	err := InTransaction(ctx, func(ctx context.Context) error {
+		publish(ctx, e)
+		return nil
+	})
+	if err != nil {
+		logging.FromContext(ctx, slog.Default()).Error("Failed to enqueue webhook deliveries", "event", e.Type, "error", err)
+	}
+}
+
+// afterCommit выполняет fn сразу или, внутри InTransaction, после фиксации; при откате fn не выполняется.
+// fn получает контекст без транзакции, поэтому её запросы идут в общий пул.
+func afterCommit(ctx context.Context, fn func(ctx context.Context)) {
+	if state, ok := ctx.Value(txKey{}).(*txState); ok {
+		state.hooks = append(state.hooks, fn)
+		return
+	}
+	fn(ctx)
+}
@@ -0,0 +1,189 @@
+package postgres
+
+import (
+	"Music_Library/internal/events"
+	"Music_Library/internal/models"
+	"context"
+	"encoding/json"
+	"fmt"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"slices"
+	"time"
+)
+
+// AddWebhook сохраняет новую подписку.
+func AddWebhook(ctx context.Context, webhook *models.Webhook) error {
+	ctx, span := startSpan(ctx, "AddWebhook")
+	defer span.End()
+
+	webhook.Active = true
+	return translate(DB.WithContext(ctx).Create(webhook).Error, "webhook", "id", webhook.ID)
+}
+
+// GetWebhooks возвращает все подписки.
+func GetWebhooks(ctx context.Context) ([]models.Webhook, error) {
+	ctx, span := startSpan(ctx, "GetWebhooks")
+	defer span.End()
+
+	var webhooks []models.Webhook
+	result := DB.WithContext(ctx).Order("id").Find(&webhooks)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return webhooks, nil
+}
+
+// GetWebhook возвращает подписку по её ID.
+func GetWebhook(ctx context.Context, id uint) (*models.Webhook, error) {
+	ctx, span := startSpan(ctx, "GetWebhook")
+	defer span.End()
+
+	var webhook models.Webhook
+	result := DB.WithContext(ctx).First(&webhook, id)
+	if result.Error != nil {
+		return nil, translate(result.Error, "webhook", "id", id)
+	}
+	return &webhook, nil
+}
+
+// DeleteWebhook удаляет подписку вместе с журналом доставок.
+func DeleteWebhook(ctx context.Context, id uint) error {
+	ctx, span := startSpan(ctx, "DeleteWebhook")
+	defer span.End()
+
+	return DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("webhook_id = ?", id).Delete(&models.WebhookDelivery{}).Error; err != nil {
+			return err
+		}
+		result := tx.Delete(&models.Webhook{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return &NotFoundError{Entity: "webhook", Field: "id", Value: id}
+		}
+		return nil
+	})
+}
+
+// enqueueDeliveries ставит события в очередь доставки для всех подходящих подписок. Вызывается
+// из InTransaction перед фиксацией, так что очередь служит outbox'ом изменений каталога.
+func enqueueDeliveries(ctx context.Context, evs []events.Event) error {
+	if len(evs) == 0 {
+		return nil
+	}
+	ctx, span := startSpan(ctx, "EnqueueDeliveries")
+	defer span.End()
+
+	var webhooks []models.Webhook
+	if err := conn(ctx).Where("active = ?", true).Find(&webhooks).Error; err != nil {
+		return err
+	}
+
+	var deliveries []models.WebhookDelivery
+	now := time.Now()
+	for _, e := range evs {
+		var payload []byte
+		for _, webhook := range webhooks {
+			if !slices.ContainsFunc(webhook.Events, func(pattern string) bool { return events.Matches(pattern, e.Type) }) {
+				continue
+			}
+			if payload == nil {
+				var err error
+				if payload, err = json.Marshal(e); err != nil {
+					return fmt.Errorf("encode %s payload: %w", e.Type, err)
+				}
+			}
+			deliveries = append(deliveries, models.WebhookDelivery{
+				WebhookID:     webhook.ID,
+				Event:         string(e.Type),
+				Payload:       string(payload),
+				Status:        models.DeliveryPending,
+				NextAttemptAt: now,
+			})
+		}
+	}
+	if len(deliveries) == 0 {
+		return nil
+	}
+	return conn(ctx).Create(&deliveries).Error
+}
+
+// ClaimDueDeliveries выбирает доставки, время которых подошло, и откладывает их на lease,
+// чтобы другие обработчики не взяли их одновременно.
+func ClaimDueDeliveries(ctx context.Context, limit int, lease time.Duration) ([]models.WebhookDelivery, error) {
+	ctx, span := startSpan(ctx, "ClaimDueDeliveries")
+	defer span.End()
+
+	var deliveries []models.WebhookDelivery
+	err := DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND next_attempt_at <= ?", models.DeliveryPending, time.Now()).
+			Order("next_attempt_at").Limit(limit).Find(&deliveries).Error
+		if err != nil || len(deliveries) == 0 {
+			return err
+		}
+		ids := make([]uint, len(deliveries))
+		for i, d := range deliveries {
+			ids[i] = d.ID
+		}
+		return tx.Model(&models.WebhookDelivery{}).Where("id IN ?", ids).
+			Update("next_attempt_at", time.Now().Add(lease)).Error
+	})
+	return deliveries, err
+}
+
+// SaveDeliveryAttempt сохраняет результат попытки доставки.
+func SaveDeliveryAttempt(ctx context.Context, delivery *models.WebhookDelivery) error {
+	ctx, span := startSpan(ctx, "SaveDeliveryAttempt")
+	defer span.End()
+
+	return DB.WithContext(ctx).Model(delivery).Select("status", "attempts", "next_attempt_at", "response_status", "last_error", "delivered_at").
+		Updates(delivery).Error
+}
+
+// GetDeliveries возвращает журнал доставок подписки, начиная с последних.
+// Для несуществующей подписки возвращает NotFoundError, а не пустой журнал.
+func GetDeliveries(ctx context.Context, webhookID uint, offset, limit int) ([]models.WebhookDelivery, int64, error) {
+	ctx, span := startSpan(ctx, "GetDeliveries")
+	defer span.End()
+
+	if err := DB.WithContext(ctx).Select("id").First(&models.Webhook{}, webhookID).Error; err != nil {
+		return nil, 0, translate(err, "webhook", "id", webhookID)
+	}
+	var deliveries []models.WebhookDelivery
+	query := DB.WithContext(ctx).Model(&models.WebhookDelivery{}).Where("webhook_id = ?", webhookID)
+
+	var total int64
+	query.Count(&total)
+
+	result := query.Order("id DESC").Offset(offset).Limit(limit).Find(&deliveries)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+	return deliveries, total, nil
+}
+
+// Redeliver ставит в очередь повторную доставку того же события как новую запись журнала.
+func Redeliver(ctx context.Context, webhookID, deliveryID uint) (*models.WebhookDelivery, error) {
+	ctx, span := startSpan(ctx, "Redeliver")
+	defer span.End()
+
+	var original models.WebhookDelivery
+	result := DB.WithContext(ctx).Where("webhook_id = ?", webhookID).First(&original, deliveryID)
+	if result.Error != nil {
+		return nil, translate(result.Error, "webhook delivery", "id", deliveryID)
+	}
+	delivery := models.WebhookDelivery{
+		WebhookID:     original.WebhookID,
+		Event:         original.Event,
+		Payload:       original.Payload,
+		Status:        models.DeliveryPending,
+		NextAttemptAt: time.Now(),
+	}
+	if err := DB.WithContext(ctx).Create(&delivery).Error; err != nil {
+		return nil, err
+	}
+	return &delivery, nil
+}
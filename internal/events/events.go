@@ -0,0 +1,79 @@
+package events
+
+import (
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type Type string
+
+const (
+	SongCreated  Type = "song.created"
+	SongUpdated  Type = "song.updated"
+	SongDeleted  Type = "song.deleted"
+	LyricCreated Type = "lyric.created"
+	LyricUpdated Type = "lyric.updated"
+	LyricDeleted Type = "lyric.deleted"
+)
+
+// Types перечисляет все события, на которые можно подписаться.
+var Types = []Type{SongCreated, SongUpdated, SongDeleted, LyricCreated, LyricUpdated, LyricDeleted}
+
+// Event описывает изменение каталога, сохранённое в базе данных. Номер ID событие получает
+// при публикации в этом процессе, поэтому в очереди вебхуков, которая пишется до неё, его нет.
+type Event struct {
+	ID      uint64    `json:"id,omitempty"`
+	Type    Type      `json:"type"`
+	Time    time.Time `json:"time"`
+	SongID  uint      `json:"song_id"`
+	LyricID uint      `json:"lyric_id,omitempty"`
+	Group   string    `json:"group,omitempty"`
+	Data    any       `json:"data,omitempty"`
+}
+
+// Handler получает события синхронно, поэтому не должен надолго блокироваться.
+type Handler func(Event)
+
+var (
+	mu       sync.RWMutex
+	handlers []Handler
+	lastID   atomic.Uint64
+)
+
+func init() {
+	// Номера продолжаются от времени запуска в микросекундах: после перезапуска они больше
+	// номеров прошлого запуска, и Feed отличает старый Last-Event-ID от пропущенных событий.
+	lastID.Store(uint64(time.Now().UnixMicro()))
+}
+
+// Subscribe регистрирует обработчик всех последующих событий.
+func Subscribe(h Handler) {
+	mu.Lock()
+	defer mu.Unlock()
+	handlers = append(handlers, h)
+}
+
+// Publish присваивает событию номер и время и передаёт его всем подписчикам.
+func Publish(e Event) {
+	e.ID = lastID.Add(1)
+	if e.Time.IsZero() {
+		e.Time = time.Now().UTC()
+	}
+
+	mu.RLock()
+	defer mu.RUnlock()
+	for _, h := range handlers {
+		h(e)
+	}
+}
+
+// Matches проверяет, подходит ли тип события под шаблон: точное имя, "*" или "song.*".
+func Matches(pattern string, t Type) bool {
+	if pattern == "*" || pattern == string(t) {
+		return true
+	}
+	prefix, ok := strings.CutSuffix(pattern, "*")
+	return ok && strings.HasSuffix(prefix, ".") && strings.HasPrefix(string(t), prefix)
+}
@@ -0,0 +1,102 @@
+package events
+
+import "sync"
+
+// Feed хранит последние события в ограниченном журнале и раздаёт их подписчикам,
+// чтобы после переподключения клиент мог получить пропущенное по Last-Event-ID.
+type Feed struct {
+	mu          sync.Mutex
+	log         []Event
+	size        int
+	subscribers map[chan Event]struct{}
+	closed      bool
+}
+
+// Live — общий поток событий, на котором работает GET /events.
+var Live *Feed
+
+// SetupFeed создаёт общий поток с журналом на size событий и подписывает его на изменения каталога.
+func SetupFeed(size int) {
+	Live = NewFeed(size)
+	Subscribe(Live.publish)
+}
+
+func NewFeed(size int) *Feed {
+	return &Feed{
+		size:        size,
+		subscribers: make(map[chan Event]struct{}),
+	}
+}
+
+func (f *Feed) publish(e Event) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.log = append(f.log, e)
+	if len(f.log) > f.size {
+		f.log = f.log[len(f.log)-f.size:]
+	}
+	for ch := range f.subscribers {
+		select {
+		case ch <- e:
+		default:
+			// Клиент не успевает читать: отключаем его, после переподключения
+			// он догонит пропущенное по журналу.
+			delete(f.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+// Close отключает всех подписчиков, чтобы открытые потоки завершились при остановке сервера.
+// Новые подписчики после этого сразу получают закрытый канал.
+func (f *Feed) Close() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.closed = true
+	for ch := range f.subscribers {
+		delete(f.subscribers, ch)
+		close(ch)
+	}
+}
+
+// Subscribe возвращает события из журнала после lastID и канал новых событий.
+// complete равен false, если часть событий после lastID уже вытеснена из журнала
+// и клиенту нужно заново загрузить данные. Канал закрывается после вызова cancel
+// или если подписчик отстал, а также при остановке потока.
+func (f *Feed) Subscribe(lastID uint64) (backlog []Event, complete bool, ch <-chan Event, cancel func()) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	complete = true
+	if lastID > 0 {
+		// Продолжить можно, только если lastID выдан в этом запуске и следующие за ним события
+		// ещё в журнале. Номера прошлого запуска меньше первого номера этого (см. lastID в events.go),
+		// поэтому после перезапуска клиент получает reset, а не молча пропускает события.
+		if len(f.log) == 0 || lastID > f.log[len(f.log)-1].ID || lastID+1 < f.log[0].ID {
+			complete = false
+		}
+		for _, e := range f.log {
+			if e.ID > lastID {
+				backlog = append(backlog, e)
+			}
+		}
+	}
+
+	sub := make(chan Event, 64)
+	if f.closed {
+		close(sub)
+		return backlog, complete, sub, func() {}
+	}
+	f.subscribers[sub] = struct{}{}
+	cancel = func() {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		if _, ok := f.subscribers[sub]; ok {
+			delete(f.subscribers, sub)
+			close(sub)
+		}
+	}
+	return backlog, complete, sub, cancel
+}
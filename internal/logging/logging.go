@@ -0,0 +1,64 @@
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+)
+
+// RequestIDHeader — заголовок, в котором клиент может передать свой идентификатор запроса.
+// Сервер возвращает идентификатор в этом же заголовке ответа.
+const RequestIDHeader = "X-Request-ID"
+
+const maxRequestIDLength = 128
+
+type (
+	loggerKey    struct{}
+	requestIDKey struct{}
+)
+
+// WithLogger кладёт в контекст логгер запроса.
+func WithLogger(ctx context.Context, log *slog.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, log)
+}
+
+// FromContext возвращает логгер запроса или fallback, если в контексте его нет.
+func FromContext(ctx context.Context, fallback *slog.Logger) *slog.Logger {
+	if log, ok := ctx.Value(loggerKey{}).(*slog.Logger); ok {
+		return log
+	}
+	return fallback
+}
+
+// WithRequestID кладёт в контекст идентификатор запроса.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestID возвращает идентификатор запроса из контекста или пустую строку.
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// NewRequestID генерирует случайный идентификатор из 32 шестнадцатеричных символов.
+func NewRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// ValidRequestID проверяет идентификатор, пришедший от клиента: он попадает в логи
+// и заголовки ответа, поэтому допускаются только печатные ASCII-символы без пробелов.
+func ValidRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] <= ' ' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
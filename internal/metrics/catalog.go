@@ -0,0 +1,71 @@
+package metrics
+
+import (
+	"Music_Library/internal/database/postgres"
+	"context"
+	"github.com/prometheus/client_golang/prometheus"
+	"log/slog"
+	"time"
+)
+
+const catalogTimeout = 2 * time.Second
+
+// catalogCollector считает размеры каталога в момент опроса, поэтому значения всегда совпадают с базой,
+// в том числе после изменений из musiclib.
+type catalogCollector struct {
+	log        *slog.Logger
+	songs      *prometheus.Desc
+	lyrics     *prometheus.Desc
+	audioFiles *prometheus.Desc
+	artwork    *prometheus.Desc
+	webhooks   *prometheus.Desc
+	deliveries *prometheus.Desc
+	up         *prometheus.Desc
+}
+
+func newCatalogCollector(log *slog.Logger) *catalogCollector {
+	desc := func(name, help string, labels ...string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, "catalog", name), help, labels, nil)
+	}
+	return &catalogCollector{
+		log:        log,
+		songs:      desc("songs", "Number of songs."),
+		lyrics:     desc("lyrics", "Number of verses."),
+		audioFiles: desc("audio_files", "Number of songs with an attached audio file."),
+		artwork:    desc("artwork_images", "Number of stored artwork images of all sizes."),
+		webhooks:   desc("webhooks", "Number of webhook subscriptions."),
+		deliveries: desc("webhook_deliveries", "Number of webhook deliveries by status.", "status"),
+		up:         desc("scrape_success", "Whether the catalogue counts were read from the database."),
+	}
+}
+
+func (c *catalogCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.songs
+	ch <- c.lyrics
+	ch <- c.audioFiles
+	ch <- c.artwork
+	ch <- c.webhooks
+	ch <- c.deliveries
+	ch <- c.up
+}
+
+func (c *catalogCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), catalogTimeout)
+	defer cancel()
+
+	stats, err := postgres.GetCatalogStats(ctx)
+	if err != nil {
+		c.log.Warn("Failed to collect catalogue metrics", "error", err)
+		ch <- prometheus.MustNewConstMetric(c.up, prometheus.GaugeValue, 0)
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(c.up, prometheus.GaugeValue, 1)
+	ch <- prometheus.MustNewConstMetric(c.songs, prometheus.GaugeValue, float64(stats.Songs))
+	ch <- prometheus.MustNewConstMetric(c.lyrics, prometheus.GaugeValue, float64(stats.Lyrics))
+	ch <- prometheus.MustNewConstMetric(c.audioFiles, prometheus.GaugeValue, float64(stats.AudioFiles))
+	ch <- prometheus.MustNewConstMetric(c.artwork, prometheus.GaugeValue, float64(stats.Artwork))
+	ch <- prometheus.MustNewConstMetric(c.webhooks, prometheus.GaugeValue, float64(stats.Webhooks))
+	for status, count := range stats.Deliveries {
+		ch <- prometheus.MustNewConstMetric(c.deliveries, prometheus.GaugeValue, float64(count), status)
+	}
+}
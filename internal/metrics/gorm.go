@@ -0,0 +1,59 @@
+package metrics
+
+import (
+	"errors"
+	"gorm.io/gorm"
+	"time"
+)
+
+const startKey = "metrics:start"
+
+type registerer interface {
+	Register(name string, fn func(*gorm.DB)) error
+}
+
+// instrumentGORM добавляет колбэки, которые замеряют время каждой операции GORM.
+func instrumentGORM(db *gorm.DB) error {
+	cb := db.Callback()
+	register := func(operation string, before, after registerer) error {
+		if err := before.Register("metrics:before_"+operation, startTimer); err != nil {
+			return err
+		}
+		return after.Register("metrics:after_"+operation, observe(operation))
+	}
+
+	return errors.Join(
+		register("create", cb.Create().Before("gorm:create"), cb.Create().After("gorm:create")),
+		register("query", cb.Query().Before("gorm:query"), cb.Query().After("gorm:query")),
+		register("update", cb.Update().Before("gorm:update"), cb.Update().After("gorm:update")),
+		register("delete", cb.Delete().Before("gorm:delete"), cb.Delete().After("gorm:delete")),
+		register("row", cb.Row().Before("gorm:row"), cb.Row().After("gorm:row")),
+		register("raw", cb.Raw().Before("gorm:raw"), cb.Raw().After("gorm:raw")),
+	)
+}
+
+func startTimer(db *gorm.DB) {
+	db.InstanceSet(startKey, time.Now())
+}
+
+func observe(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		value, ok := db.InstanceGet(startKey)
+		if !ok {
+			return
+		}
+		start, ok := value.(time.Time)
+		if !ok {
+			return
+		}
+		table := db.Statement.Table
+		if table == "" {
+			table = "unknown"
+		}
+		status := "ok"
+		if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+			status = "error"
+		}
+		dbQueryDuration.WithLabelValues(operation, table, status).Observe(time.Since(start).Seconds())
+	}
+}
@@ -0,0 +1,86 @@
+package metrics
+
+import (
+	"Music_Library/internal/database/postgres"
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const namespace = "music_library"
+
+var (
+	httpRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of HTTP requests by route template, method and status.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"route", "method", "status"})
+
+	httpRequestsInFlight = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_in_flight",
+		Help:      "Number of HTTP requests being served.",
+	})
+
+	dbQueryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "query_duration_seconds",
+		Help:      "Duration of GORM queries by operation, table and outcome.",
+		Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+	}, []string{"operation", "table", "status"})
+)
+
+// Setup регистрирует метрики HTTP, запросов GORM, пула соединений и размеров каталога.
+// Вызывается после postgres.SetupDatabase.
+func Setup(log *slog.Logger) error {
+	sqlDB, err := postgres.DB.DB()
+	if err != nil {
+		return err
+	}
+	if err = instrumentGORM(postgres.DB); err != nil {
+		return err
+	}
+
+	prometheus.MustRegister(
+		httpRequestDuration,
+		httpRequestsInFlight,
+		dbQueryDuration,
+		collectors.NewDBStatsCollector(sqlDB, "postgres"),
+		newCatalogCollector(log),
+	)
+	return nil
+}
+
+// Handler отдаёт метрики в текстовом формате Prometheus.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// Middleware замеряет время обработки запросов. Запросы помечаются шаблоном маршрута,
+// например /songs/:id, чтобы число рядов не зависело от ID в адресе.
+func Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		httpRequestsInFlight.Inc()
+		defer httpRequestsInFlight.Dec()
+
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+		httpRequestDuration.
+			WithLabelValues(route, c.Request.Method, strconv.Itoa(c.Writer.Status())).
+			Observe(time.Since(start).Seconds())
+	}
+}
@@ -0,0 +1,49 @@
+package models
+
+import "encoding/json"
+
+// MaxBatchOperations — сколько операций можно передать в одном запросе POST /batch.
+const MaxBatchOperations = 500
+
+// BatchRequest represents a list of operations on songs and lyrics
+// @Description Batch of operations executed in one request
+type BatchRequest struct {
+	// Atomic выполняет все операции в одной транзакции: при первой ошибке изменения откатываются.
+	Atomic     bool             `json:"atomic"`
+	Operations []BatchOperation `json:"operations"`
+}
+
+// BatchOperation represents one operation of a batch
+// @Description Operation on a song or a lyric
+type BatchOperation struct {
+	Op       string `json:"op" enums:"get,create,update,patch,delete" example:"create"`
+	Resource string `json:"resource" enums:"song,lyric" example:"song"`
+	// ID обязателен для всех операций, кроме create.
+	ID uint `json:"id,omitempty" example:"42"`
+	// Data — тело операции: объект для create и update, JSON Merge Patch для patch.
+	Data json.RawMessage `json:"data,omitempty" swaggertype:"object"`
+}
+
+// BatchResponse represents the results of a batch
+// @Description Results of a batch in the order of its operations
+type BatchResponse struct {
+	// Committed равен false, если атомарный пакет откатился.
+	Committed bool          `json:"committed"`
+	Results   []BatchResult `json:"results"`
+}
+
+// BatchResult represents the outcome of one operation
+// @Description Outcome of one operation
+type BatchResult struct {
+	Status int         `json:"status" example:"201"`
+	Data   any         `json:"data,omitempty" swaggertype:"object"`
+	Error  *BatchError `json:"error,omitempty"`
+}
+
+// BatchError describes why an operation failed
+// @Description Error of one operation
+type BatchError struct {
+	Code   string       `json:"code" example:"not_found"`
+	Detail string       `json:"detail" example:"song with id 42 not found"`
+	Errors []FieldError `json:"errors,omitempty"`
+}
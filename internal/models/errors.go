@@ -1,11 +1,84 @@
 package models
 
-import "github.com/gin-gonic/gin"
+import (
+	"Music_Library/internal/logging"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strings"
+)
 
+// ProblemContentType — тип содержимого ответов об ошибках (RFC 7807).
+const ProblemContentType = "application/problem+json"
+
+// Машиночитаемые коды ошибок. Для статусов без своего кода используется текст статуса
+// в snake_case, например "request_entity_too_large".
+const (
+	CodeBadRequest       = "bad_request"
+	CodeValidationFailed = "validation_failed"
+	CodeNotFound         = "not_found"
+	CodeConflict         = "conflict"
+	CodeInternal         = "internal_error"
+)
+
+// Коды ошибок отдельных полей.
+const (
+	CodeRequired = "required"
+	CodeInvalid  = "invalid"
+	CodeTooLong  = "too_long"
+)
+
+// ErrorResponse represents an error in the RFC 7807 problem details format
+// @Description Problem details (application/problem+json)
 type ErrorResponse struct {
-	Error string `json:"error"`
+	Type      string       `json:"type" example:"about:blank"`
+	Title     string       `json:"title" example:"Not Found"`
+	Status    int          `json:"status" example:"404"`
+	Detail    string       `json:"detail,omitempty" example:"song with id 42 not found"`
+	Instance  string       `json:"instance,omitempty" example:"/songs/42"`
+	Code      string       `json:"code" example:"not_found"`
+	RequestID string       `json:"request_id,omitempty"`
+	Errors    []FieldError `json:"errors,omitempty"`
+}
+
+// FieldError describes why the value of one field was rejected
+// @Description Invalid field
+type FieldError struct {
+	Field   string `json:"field" example:"song_id"`
+	Code    string `json:"code" example:"not_found"`
+	Message string `json:"message" example:"references a record that does not exist"`
 }
 
+// NewErrorResponse прерывает обработку запроса и отвечает ошибкой с кодом по статусу.
 func NewErrorResponse(c *gin.Context, status int, err string) {
-	c.AbortWithStatusJSON(status, ErrorResponse{Error: err})
+	NewProblem(c, status, StatusCode(status), err, nil)
+}
+
+// NewProblem прерывает обработку запроса и отвечает ошибкой с кодом code и ошибками полей fields.
+func NewProblem(c *gin.Context, status int, code, detail string, fields []FieldError) {
+	c.Header("Content-Type", ProblemContentType)
+	c.AbortWithStatusJSON(status, ErrorResponse{
+		Type:      "about:blank",
+		Title:     http.StatusText(status),
+		Status:    status,
+		Detail:    detail,
+		Instance:  c.Request.URL.Path,
+		Code:      code,
+		RequestID: logging.RequestID(c.Request.Context()),
+		Errors:    fields,
+	})
+}
+
+// StatusCode возвращает код ошибки для статуса HTTP.
+func StatusCode(status int) string {
+	switch status {
+	case http.StatusBadRequest:
+		return CodeBadRequest
+	case http.StatusNotFound:
+		return CodeNotFound
+	case http.StatusConflict:
+		return CodeConflict
+	case http.StatusInternalServerError:
+		return CodeInternal
+	}
+	return strings.ToLower(strings.ReplaceAll(http.StatusText(status), " ", "_"))
 }
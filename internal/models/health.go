@@ -0,0 +1,28 @@
+package models
+
+const (
+	StatusUp   = "up"
+	StatusDown = "down"
+)
+
+// HealthResponse represents the liveness status
+// @Description Liveness status
+type HealthResponse struct {
+	Status string `json:"status" example:"ok"`
+}
+
+// ReadinessResponse represents the readiness status with the state of each dependency
+// @Description Readiness status
+type ReadinessResponse struct {
+	Status string                 `json:"status" example:"ready"`
+	Checks map[string]CheckResult `json:"checks"`
+}
+
+// CheckResult represents the state of one dependency
+// @Description Dependency check result
+type CheckResult struct {
+	Status    string         `json:"status" example:"up"`
+	LatencyMS int64          `json:"latency_ms"`
+	Error     string         `json:"error,omitempty"`
+	Details   map[string]any `json:"details,omitempty"`
+}
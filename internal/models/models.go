@@ -1,14 +1,20 @@
 package models
 
+import (
+	"gorm.io/gorm"
+	"time"
+)
+
 // Song represents a song
 // @Description Song model
 type Song struct {
-	ID          uint    `gorm:"primaryKey"`
-	Group       string  `json:"group"`
-	Title       string  `json:"title"`
-	ReleaseDate string  `json:"release_date"`
-	Link        string  `json:"link"`
-	Lyrics      []Lyric `json:"lyrics" gorm:"foreignKey:SongID"`
+	ID          uint        `gorm:"primaryKey"`
+	Group       string      `json:"group" validate:"required,max=255"`
+	Title       string      `json:"title" validate:"required,max=255"`
+	ReleaseDate ReleaseDate `json:"release_date" gorm:"embedded" validate:"omitempty,release_date" swaggertype:"string" example:"2006-07-16"`
+	Link        string      `json:"link" validate:"omitempty,http_url"`
+	Lyrics      []Lyric     `json:"lyrics" gorm:"foreignKey:SongID" validate:"dive"`
+	Artwork     []Artwork   `json:"artwork,omitempty" gorm:"foreignKey:SongID"`
 }
 
 // Lyric represents a song lyric
@@ -16,6 +22,84 @@ type Song struct {
 type Lyric struct {
 	ID          uint   `gorm:"primaryKey"`
 	SongID      uint   `json:"song_id"`
-	VerseNumber int    `json:"verse_number"`
-	Text        string `json:"text"`
+	VerseNumber int    `json:"verse_number" validate:"gt=0"`
+	Text        string `json:"text" validate:"required"`
+}
+
+// LyricOrder represents a new order of the verses of a song
+// @Description IDs of all verses of a song in the desired order
+type LyricOrder struct {
+	IDs []uint `json:"ids" example:"7,5,6"`
 }
+
+// AudioFile represents an audio file attached to a song
+// @Description Audio file metadata
+type AudioFile struct {
+	ID          uint   `gorm:"primaryKey"`
+	SongID      uint   `json:"song_id" gorm:"uniqueIndex"`
+	Checksum    string `json:"checksum" gorm:"index"`
+	Size        int64  `json:"size"`
+	ContentType string `json:"content_type"`
+	Filename    string `json:"filename"`
+}
+
+// Artwork represents one size of a song's cover art
+// @Description Cover art image of a fixed size
+type Artwork struct {
+	ID          uint   `json:"-" gorm:"primaryKey"`
+	SongID      uint   `json:"-" gorm:"uniqueIndex:idx_artwork_song_size"`
+	Size        string `json:"size" gorm:"uniqueIndex:idx_artwork_song_size"`
+	Key         string `json:"-" gorm:"index"`
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+	ContentType string `json:"content_type"`
+	URL         string `json:"url" gorm:"-"`
+}
+
+// AfterFind заполняет адрес, по которому отдаётся изображение.
+func (a *Artwork) AfterFind(*gorm.DB) error {
+	a.URL = "/artwork/" + a.Key
+	return nil
+}
+
+// Webhook represents a subscription to catalogue change events
+// @Description Webhook subscription
+type Webhook struct {
+	ID        uint      `gorm:"primaryKey"`
+	URL       string    `json:"url"`
+	Events    []string  `json:"events" gorm:"serializer:json"`
+	Secret    string    `json:"secret,omitempty"`
+	Active    bool      `json:"active" gorm:"default:true"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// WebhookInput represents a new webhook subscription
+// @Description Webhook URL, event types and optional secret
+type WebhookInput struct {
+	URL    string   `json:"url" example:"https://example.com/hooks/music"`
+	Events []string `json:"events" example:"song.*,lyric.created"`
+	// Secret подписывает доставки; если его нет, сервер создаёт свой.
+	Secret string `json:"secret,omitempty"`
+}
+
+// WebhookDelivery represents one attempt to deliver an event to a webhook
+// @Description Webhook delivery log entry
+type WebhookDelivery struct {
+	ID             uint       `gorm:"primaryKey"`
+	WebhookID      uint       `json:"webhook_id" gorm:"index"`
+	Event          string     `json:"event"`
+	Payload        string     `json:"payload" gorm:"type:text"`
+	Status         string     `json:"status" gorm:"index:idx_delivery_due,priority:1"`
+	Attempts       int        `json:"attempts"`
+	NextAttemptAt  time.Time  `json:"next_attempt_at" gorm:"index:idx_delivery_due,priority:2"`
+	ResponseStatus int        `json:"response_status"`
+	LastError      string     `json:"last_error"`
+	CreatedAt      time.Time  `json:"created_at"`
+	DeliveredAt    *time.Time `json:"delivered_at"`
+}
+
+const (
+	DeliveryPending   = "pending"
+	DeliveryDelivered = "delivered"
+	DeliveryFailed    = "failed"
+)
@@ -0,0 +1,143 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DatePrecision — с какой точностью известна дата выхода.
+type DatePrecision string
+
+const (
+	PrecisionYear  DatePrecision = "year"
+	PrecisionMonth DatePrecision = "month"
+	PrecisionDay   DatePrecision = "day"
+)
+
+// InvalidReleaseDate — сообщение об ошибке для даты выхода в неизвестном формате.
+const InvalidReleaseDate = "must be a date in YYYY-MM-DD, DD.MM.YYYY, YYYY-MM or YYYY format"
+
+// releaseDateLayouts — форматы, в которых принимается дата выхода, и точность каждого.
+var releaseDateLayouts = []struct {
+	layout    string
+	precision DatePrecision
+}{
+	{"2006-01-02", PrecisionDay},
+	{"02.01.2006", PrecisionDay},
+	{"2006/01/02", PrecisionDay},
+	{"2006-01", PrecisionMonth},
+	{"01.2006", PrecisionMonth},
+	{"2006", PrecisionYear},
+}
+
+// ReleaseDate — дата выхода песни с точностью до года, месяца или дня. Неполная дата
+// хранится как первый день периода. В JSON передаётся строкой "1997", "1997-07" или "1997-07-16",
+// неизвестная дата — null.
+type ReleaseDate struct {
+	Time      *time.Time    `gorm:"column:released_on;type:date;index"`
+	Precision DatePrecision `gorm:"column:release_precision;size:5"`
+
+	// invalid хранит строку, которую не удалось разобрать, чтобы Validate сообщил
+	// о ней вместе с остальными ошибками.
+	invalid string
+}
+
+// ParseReleaseDate разбирает дату в одном из форматов YYYY-MM-DD, DD.MM.YYYY, YYYY/MM/DD,
+// YYYY-MM, MM.YYYY или YYYY. Пустая строка даёт неизвестную дату.
+func ParseReleaseDate(s string) (ReleaseDate, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return ReleaseDate{}, nil
+	}
+	for _, l := range releaseDateLayouts {
+		if t, err := time.Parse(l.layout, s); err == nil {
+			return ReleaseDate{Time: &t, Precision: l.precision}, nil
+		}
+	}
+	return ReleaseDate{}, fmt.Errorf("invalid release date %q", s)
+}
+
+// NewReleaseDate разбирает дату как ParseReleaseDate, но не возвращает ошибку:
+// дату в неизвестном формате отклонит Validate песни.
+func NewReleaseDate(s string) ReleaseDate {
+	d, err := ParseReleaseDate(s)
+	if err != nil {
+		d.invalid = s
+	}
+	return d
+}
+
+// Year возвращает дату с точностью до года.
+func Year(year int) ReleaseDate {
+	t := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	return ReleaseDate{Time: &t, Precision: PrecisionYear}
+}
+
+// Valid сообщает, удалось ли разобрать дату. Неизвестная дата считается правильной.
+func (d ReleaseDate) Valid() bool {
+	return d.invalid == ""
+}
+
+func (d ReleaseDate) IsZero() bool {
+	return d.Time == nil && d.invalid == ""
+}
+
+// Start возвращает первый день периода.
+func (d ReleaseDate) Start() time.Time {
+	if d.Time == nil {
+		return time.Time{}
+	}
+	return *d.Time
+}
+
+// End возвращает последний день периода: 31 декабря для года, последний день месяца для месяца.
+func (d ReleaseDate) End() time.Time {
+	switch d.Precision {
+	case PrecisionYear:
+		return d.Start().AddDate(1, 0, -1)
+	case PrecisionMonth:
+		return d.Start().AddDate(0, 1, -1)
+	}
+	return d.Start()
+}
+
+func (d ReleaseDate) String() string {
+	if d.Time == nil {
+		return d.invalid
+	}
+	switch d.Precision {
+	case PrecisionYear:
+		return d.Time.Format("2006")
+	case PrecisionMonth:
+		return d.Time.Format("2006-01")
+	}
+	return d.Time.Format("2006-01-02")
+}
+
+func (d ReleaseDate) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(d.String())
+}
+
+func (d *ReleaseDate) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*d = ReleaseDate{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		// Год можно передать и числом.
+		var year int
+		if json.Unmarshal(data, &year) != nil {
+			return fmt.Errorf("release date must be a string")
+		}
+		s = fmt.Sprint(year)
+	}
+	*d = NewReleaseDate(s)
+	return nil
+}
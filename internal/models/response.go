@@ -16,3 +16,11 @@ func NewResponse(c *gin.Context, id int, message string) {
 		Message: message,
 	})
 }
+
+// ImportResponse represents the result of creating a song from an audio file
+// @Description Song import result
+type ImportResponse struct {
+	Song           *Song    `json:"song"`
+	Created        bool     `json:"created"`
+	FieldsFromFile []string `json:"fields_from_file"`
+}
@@ -0,0 +1,127 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"github.com/go-playground/validator/v10"
+	"reflect"
+	"strings"
+)
+
+var validate = newValidator()
+
+func newValidator() *validator.Validate {
+	v := validator.New(validator.WithRequiredStructEnabled())
+	// В ошибках поля называются так же, как в JSON.
+	v.RegisterTagNameFunc(func(f reflect.StructField) string {
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			return ""
+		}
+		if name == "" {
+			return f.Name
+		}
+		return name
+	})
+	// Дата выхода проверяется как строка, в которой её передал клиент.
+	v.RegisterCustomTypeFunc(func(field reflect.Value) any {
+		return field.Interface().(ReleaseDate).String()
+	}, ReleaseDate{})
+	_ = v.RegisterValidation("release_date", func(fl validator.FieldLevel) bool {
+		_, err := ParseReleaseDate(fl.Field().String())
+		return err == nil
+	})
+	return v
+}
+
+// Validate проверяет все поля песни и её куплетов по тегам validate.
+func (s *Song) Validate() []FieldError {
+	return append(fieldErrors(validate.Struct(s)), duplicateVerses(s.Lyrics)...)
+}
+
+// ValidatePartial проверяет только заполненные поля песни, как при частичном обновлении.
+// Переданные куплеты проверяются целиком.
+func (s *Song) ValidatePartial() []FieldError {
+	fields := fieldErrors(validate.StructPartial(s, nonZeroFields(s)...))
+	for i := range s.Lyrics {
+		for _, f := range s.Lyrics[i].Validate() {
+			f.Field = fmt.Sprintf("lyrics[%d].%s", i, f.Field)
+			fields = append(fields, f)
+		}
+	}
+	return append(fields, duplicateVerses(s.Lyrics)...)
+}
+
+// duplicateVerses сообщает о куплетах, номер которых уже занят предыдущим куплетом списка.
+func duplicateVerses(lyrics []Lyric) []FieldError {
+	var fields []FieldError
+	seen := map[int]int{}
+	for i, lyric := range lyrics {
+		if lyric.VerseNumber <= 0 {
+			continue
+		}
+		if first, ok := seen[lyric.VerseNumber]; ok {
+			fields = append(fields, FieldError{Field: fmt.Sprintf("lyrics[%d].verse_number", i), Code: CodeInvalid,
+				Message: fmt.Sprintf("duplicates verse number of lyrics[%d]", first)})
+			continue
+		}
+		seen[lyric.VerseNumber] = i
+	}
+	return fields
+}
+
+// Validate проверяет все поля куплета по тегам validate. Существование песни
+// проверяет репозиторий.
+func (l *Lyric) Validate() []FieldError {
+	return fieldErrors(validate.Struct(l))
+}
+
+// ValidatePartial проверяет только заполненные поля куплета.
+func (l *Lyric) ValidatePartial() []FieldError {
+	return fieldErrors(validate.StructPartial(l, nonZeroFields(l)...))
+}
+
+// nonZeroFields возвращает имена заполненных полей структуры, на которую указывает v.
+func nonZeroFields(v any) []string {
+	value := reflect.ValueOf(v).Elem()
+	var names []string
+	for i := 0; i < value.NumField(); i++ {
+		if !value.Field(i).IsZero() {
+			names = append(names, value.Type().Field(i).Name)
+		}
+	}
+	return names
+}
+
+func fieldErrors(err error) []FieldError {
+	var errs validator.ValidationErrors
+	if !errors.As(err, &errs) {
+		return nil
+	}
+	fields := make([]FieldError, len(errs))
+	for i, fe := range errs {
+		// Namespace начинается с имени типа: "Song.lyrics[0].text".
+		_, field, _ := strings.Cut(fe.Namespace(), ".")
+		fields[i] = FieldError{Field: field, Code: CodeInvalid, Message: fieldMessage(fe)}
+		if fe.Tag() == "required" {
+			fields[i].Code = CodeRequired
+		}
+	}
+	return fields
+}
+
+func fieldMessage(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return "is required"
+	case "http_url":
+		return "must be an absolute http or https URL"
+	case "release_date":
+		return InvalidReleaseDate
+	case "gt":
+		return "must be greater than " + fe.Param()
+	case "max":
+		return "must be at most " + fe.Param() + " characters long"
+	}
+	return "failed the " + fe.Tag() + " check"
+}
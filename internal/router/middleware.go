@@ -0,0 +1,112 @@
+package router
+
+import (
+	"Music_Library/internal/logging"
+	"Music_Library/internal/models"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+	"syscall"
+	"time"
+)
+
+// requestID берёт идентификатор запроса из X-Request-ID или генерирует новый, возвращает его
+// в ответе и кладёт в контекст логгер с request_id и trace_id, который получают обработчики.
+func requestID(log *slog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(logging.RequestIDHeader)
+		if !logging.ValidRequestID(id) {
+			id = logging.NewRequestID()
+		}
+		c.Header(logging.RequestIDHeader, id)
+
+		ctx := c.Request.Context()
+		attrs := []any{"request_id", id}
+		if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+			attrs = append(attrs, "trace_id", sc.TraceID().String())
+			trace.SpanFromContext(ctx).SetAttributes(attribute.String("http.request.id", id))
+		}
+		ctx = logging.WithRequestID(ctx, id)
+		ctx = logging.WithLogger(ctx, log.With(attrs...))
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
+
+// accessLog пишет по одной записи на запрос в log, независимо от формата основного логгера.
+// Проверки живости и сбор метрик логируются на уровне Debug, чтобы не забивать журнал.
+func accessLog(log *slog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+		ctx := c.Request.Context()
+		status := c.Writer.Status()
+		attrs := []slog.Attr{
+			slog.String("request_id", logging.RequestID(ctx)),
+			slog.String("method", c.Request.Method),
+			slog.String("route", route),
+			slog.String("path", c.Request.URL.Path),
+			slog.Int("status", status),
+			slog.Float64("latency_ms", float64(time.Since(start).Microseconds())/1000),
+			slog.String("client_ip", c.ClientIP()),
+			slog.String("user_agent", c.Request.UserAgent()),
+			slog.Int64("bytes_in", max(c.Request.ContentLength, 0)),
+			slog.Int("bytes_out", max(c.Writer.Size(), 0)),
+		}
+		if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+			attrs = append(attrs, slog.String("trace_id", sc.TraceID().String()))
+		}
+		if len(c.Errors) > 0 {
+			attrs = append(attrs, slog.String("errors", c.Errors.String()))
+		}
+
+		level := slog.LevelInfo
+		switch {
+		case status >= http.StatusInternalServerError:
+			level = slog.LevelError
+		case status >= http.StatusBadRequest:
+			level = slog.LevelWarn
+		case route == "/healthz" || route == "/readyz" || route == "/metrics":
+			level = slog.LevelDebug
+		}
+		log.LogAttrs(ctx, level, "HTTP request", attrs...)
+	}
+}
+
+// recovery перехватывает панику в обработчике и отвечает 500 в формате models.ErrorResponse.
+// Если клиент уже закрыл соединение, ответ не пишется.
+func recovery(log *slog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		defer func() {
+			r := recover()
+			if r == nil {
+				return
+			}
+			if r == http.ErrAbortHandler {
+				panic(r)
+			}
+			logger := logging.FromContext(c.Request.Context(), log)
+			if err, ok := r.(error); ok && (errors.Is(err, syscall.EPIPE) || errors.Is(err, syscall.ECONNRESET)) {
+				logger.Warn("Client connection lost", "error", err)
+				c.Abort()
+				return
+			}
+			logger.Error("Panic in handler", "panic", r, "stack", string(debug.Stack()))
+			if c.Writer.Written() {
+				c.Abort()
+				return
+			}
+			models.NewErrorResponse(c, http.StatusInternalServerError, "internal server error")
+		}()
+		c.Next()
+	}
+}
@@ -1,52 +1,86 @@
 package router
 
 import (
+	"Music_Library/config"
 	"Music_Library/docs"
+	"Music_Library/internal/metrics"
+	"Music_Library/internal/models"
+	"Music_Library/internal/transport/gql"
 	"Music_Library/internal/transport/handlers"
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 	"log/slog"
+	"net/http"
 )
 
-func NewRouter(log *slog.Logger) *gin.Engine {
-	router := gin.Default()
+func NewRouter(log *slog.Logger, cfg *config.Config) *gin.Engine {
+	router := gin.New()
+	router.Use(
+		otelgin.Middleware(cfg.Tracing.ServiceName),
+		requestID(log),
+		accessLog(log),
+		metrics.Middleware(),
+		recovery(log),
+	)
+	router.NoRoute(func(c *gin.Context) {
+		models.NewErrorResponse(c, http.StatusNotFound, "route not found")
+	})
 	docs.SwaggerInfo.BasePath = "/"
 	router.GET("/docs/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
+	router.GET("/metrics", gin.WrapH(metrics.Handler()))
+	router.GET("/healthz", handlers.Healthz)
+	router.GET("/readyz", handle(log, handlers.Readyz))
+
 	songRouter := router.Group("/songs")
 	{
-		songRouter.GET("/", func(c *gin.Context) {
-			handlers.GetAllSongs(c, log)
-		})
-		songRouter.POST("/", func(c *gin.Context) {
-			handlers.AddSong(c, log)
-		})
-		songRouter.GET("/:id", func(c *gin.Context) {
-			handlers.GetSong(c, log)
-		})
-		songRouter.PUT("/:id", func(c *gin.Context) {
-			handlers.UpdateSong(c, log)
-		})
-		songRouter.DELETE("/:id", func(c *gin.Context) {
-			handlers.DeleteSong(c, log)
-		})
+		songRouter.GET("/", handle(log, handlers.GetAllSongs))
+		songRouter.POST("/", handle(log, handlers.AddSong))
+		songRouter.POST("/upload", handle(log, handlers.ImportSong))
+		songRouter.GET("/:id", handle(log, handlers.GetSong))
+		songRouter.PUT("/:id", handle(log, handlers.UpdateSong))
+		songRouter.PATCH("/:id", handle(log, handlers.PatchSong))
+		songRouter.DELETE("/:id", handle(log, handlers.DeleteSong))
+		songRouter.GET("/:id/lyrics", handle(log, handlers.GetSongLyrics))
+		songRouter.POST("/:id/lyrics", handle(log, handlers.AddSongLyric))
+		songRouter.POST("/:id/lyrics/reorder", handle(log, handlers.ReorderSongLyrics))
+		songRouter.GET("/:id/lyrics/:verse_number", handle(log, handlers.GetSongVerse))
+		songRouter.PUT("/:id/audio", handle(log, handlers.UploadAudio))
+		songRouter.GET("/:id/audio", handle(log, handlers.StreamAudio))
+		songRouter.HEAD("/:id/audio", handle(log, handlers.StreamAudio))
+		songRouter.DELETE("/:id/audio", handle(log, handlers.DeleteAudio))
+		songRouter.PUT("/:id/artwork", handle(log, handlers.UploadArtwork))
+		songRouter.DELETE("/:id/artwork", handle(log, handlers.DeleteArtwork))
 	}
 
+	router.GET("/artwork/:key", handle(log, handlers.GetArtwork))
+
 	lyricsRouter := router.Group("/lyrics")
 	{
-		lyricsRouter.GET("/:id", func(c *gin.Context) {
-			handlers.GetLyric(c, log)
-		})
-		lyricsRouter.POST("/", func(c *gin.Context) {
-			handlers.AddLyric(c, log)
-		})
-		lyricsRouter.PUT("/:id", func(c *gin.Context) {
-			handlers.UpdateLyric(c, log)
-		})
-		lyricsRouter.DELETE("/:id", func(c *gin.Context) {
-			handlers.DeleteLyric(c, log)
-		})
+		lyricsRouter.GET("/:id", handle(log, handlers.GetLyric))
+		lyricsRouter.POST("/", handle(log, handlers.AddLyric))
+		lyricsRouter.PUT("/:id", handle(log, handlers.UpdateLyric))
+		lyricsRouter.PATCH("/:id", handle(log, handlers.PatchLyric))
+		lyricsRouter.DELETE("/:id", handle(log, handlers.DeleteLyric))
+	}
+
+	router.POST("/batch", handle(log, handlers.Batch))
+
+	router.POST("/graphql", handle(log, gql.Handle))
+	router.GET("/graphql", handle(log, gql.Handle))
+
+	router.GET("/events", handle(log, handlers.StreamEvents))
+
+	webhookRouter := router.Group("/webhooks")
+	{
+		webhookRouter.GET("/", handle(log, handlers.GetWebhooks))
+		webhookRouter.POST("/", handle(log, handlers.AddWebhook))
+		webhookRouter.GET("/:id", handle(log, handlers.GetWebhook))
+		webhookRouter.DELETE("/:id", handle(log, handlers.DeleteWebhook))
+		webhookRouter.GET("/:id/deliveries", handle(log, handlers.GetWebhookDeliveries))
+		webhookRouter.POST("/:id/deliveries/:delivery_id/redeliver", handle(log, handlers.RedeliverWebhook))
 	}
 
 	return router
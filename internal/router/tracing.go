@@ -0,0 +1,33 @@
+package router
+
+import (
+	"Music_Library/internal/logging"
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"log/slog"
+	"net/http"
+	"path"
+	"reflect"
+	"runtime"
+)
+
+var tracer = otel.Tracer("Music_Library/internal/router")
+
+// handle оборачивает обработчик в span с именем функции, например handlers.GetSong.
+// Контекст span'а передаётся дальше через c.Request, поэтому запросы к репозиторию становятся его потомками.
+// Обработчик получает логгер запроса с request_id и trace_id, а если его нет — общий log.
+func handle(log *slog.Logger, h func(*gin.Context, *slog.Logger)) gin.HandlerFunc {
+	name := path.Base(runtime.FuncForPC(reflect.ValueOf(h).Pointer()).Name())
+	return func(c *gin.Context) {
+		ctx, span := tracer.Start(c.Request.Context(), name)
+		defer span.End()
+		c.Request = c.Request.WithContext(ctx)
+
+		h(c, logging.FromContext(ctx, log))
+
+		if status := c.Writer.Status(); status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(status))
+		}
+	}
+}
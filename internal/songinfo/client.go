@@ -0,0 +1,193 @@
+package songinfo
+
+import (
+	"Music_Library/config"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+var (
+	ErrNotFound    = errors.New("song info not found")
+	ErrCircuitOpen = errors.New("song info service is unavailable, circuit breaker is open")
+)
+
+// SongDetail — ответ сервиса GET /info.
+type SongDetail struct {
+	ReleaseDate string `json:"releaseDate"`
+	Text        string `json:"text"`
+	Link        string `json:"link"`
+}
+
+// Options задаёт таймауты, повторы и параметры автомата защиты.
+type Options struct {
+	Timeout          time.Duration
+	Retries          int
+	Backoff          time.Duration
+	FailureThreshold int
+	OpenTimeout      time.Duration
+	// Deadline ограничивает один вызов Info вместе со всеми повторами и задержками между ними.
+	// Ноль — без общего ограничения.
+	Deadline time.Duration
+	// HTTPClient позволяет подменить транспорт, например в тестах. По умолчанию используется
+	// http.Client с таймаутом Timeout.
+	HTTPClient *http.Client
+}
+
+// Client — HTTP-клиент внешнего сервиса с информацией о песнях.
+type Client struct {
+	baseURL  string
+	http     *http.Client
+	retries  int
+	backoff  time.Duration
+	deadline time.Duration
+	breaker  *breaker
+}
+
+// Default — клиент, созданный из конфигурации. Равен nil, если адрес сервиса не задан.
+var Default *Client
+
+func Setup(log *slog.Logger, cfg *config.Config) {
+	if cfg.SongInfo.URL == "" {
+		log.Info("Song info service is not configured, enrichment is disabled")
+		return
+	}
+	Default = New(cfg.SongInfo.URL, Options{
+		Timeout:          cfg.SongInfo.Timeout,
+		Deadline:         cfg.SongInfo.Deadline,
+		Retries:          cfg.SongInfo.Retries,
+		Backoff:          cfg.SongInfo.Backoff,
+		FailureThreshold: cfg.SongInfo.FailureThreshold,
+		OpenTimeout:      cfg.SongInfo.OpenTimeout,
+	})
+	log.Info("Song info client configured", "url", cfg.SongInfo.URL)
+}
+
+func New(baseURL string, opts Options) *Client {
+	httpClient := opts.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: opts.Timeout}
+	}
+	return &Client{
+		baseURL:  strings.TrimRight(baseURL, "/"),
+		http:     httpClient,
+		retries:  opts.Retries,
+		backoff:  opts.Backoff,
+		deadline: opts.Deadline,
+		breaker:  newBreaker(opts.FailureThreshold, opts.OpenTimeout),
+	}
+}
+
+// Info запрашивает сведения о песне. Сетевые ошибки, ответы 429 и 5xx повторяются
+// с экспоненциальной задержкой, но не дольше Options.Deadline в сумме.
+func (c *Client) Info(ctx context.Context, group, song string) (*SongDetail, error) {
+	if !c.breaker.allow() {
+		return nil, ErrCircuitOpen
+	}
+	parent := ctx
+	if c.deadline > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.deadline)
+		defer cancel()
+	}
+
+	query := url.Values{"group": {group}, "song": {song}}
+	endpoint := c.baseURL + "/info?" + query.Encode()
+
+	var lastErr error
+	for attempt := 0; attempt <= c.retries; attempt++ {
+		if attempt > 0 {
+			if err := sleep(ctx, c.delay(attempt)); err != nil {
+				// Предыдущая попытка уже завершилась ошибкой; без исхода пробный запрос
+				// полуоткрытого автомата так и остался бы незавершённым.
+				c.fail(parent)
+				return nil, err
+			}
+		}
+
+		detail, retry, err := c.do(ctx, endpoint)
+		if err == nil {
+			c.breaker.success()
+			return detail, nil
+		}
+		if !retry {
+			// Ответ 4xx означает, что сервис работает, поэтому автомат защиты не срабатывает.
+			c.breaker.success()
+			return nil, err
+		}
+		lastErr = err
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	c.fail(parent)
+	return nil, lastErr
+}
+
+// fail учитывает неудачный вызов в автомате защиты. Если вызов отменил сам клиент,
+// сервис ни при чём: ошибка не считается, а пробный запрос просто освобождается.
+func (c *Client) fail(ctx context.Context) {
+	if errors.Is(ctx.Err(), context.Canceled) {
+		c.breaker.release()
+		return
+	}
+	c.breaker.failure()
+}
+
+// do выполняет один запрос и сообщает, имеет ли смысл его повторить.
+func (c *Client) do(ctx context.Context, endpoint string) (*SongDetail, bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, true, err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusOK:
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, false, ErrNotFound
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return nil, true, fmt.Errorf("song info service responded with %s", resp.Status)
+	default:
+		return nil, false, fmt.Errorf("song info service responded with %s", resp.Status)
+	}
+
+	var detail SongDetail
+	if err = json.NewDecoder(resp.Body).Decode(&detail); err != nil {
+		return nil, false, fmt.Errorf("decode song info: %w", err)
+	}
+	return &detail, false, nil
+}
+
+// delay возвращает задержку перед повтором: backoff * 2^(attempt-1) со случайным разбросом до 50%.
+func (c *Client) delay(attempt int) time.Duration {
+	d := c.backoff << (attempt - 1)
+	if d <= 0 {
+		return 0
+	}
+	return d/2 + rand.N(d/2+1)
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
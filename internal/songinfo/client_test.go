@@ -0,0 +1,209 @@
+package songinfo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// stub — локальный сервис /info, отвечающий статусами из statuses по очереди;
+// после их окончания отвечает последним.
+type stub struct {
+	*httptest.Server
+	calls    atomic.Int32
+	statuses []int
+}
+
+func newStub(t *testing.T, statuses ...int) *stub {
+	s := &stub{statuses: statuses}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(s.calls.Add(1))
+		status := s.statuses[min(n, len(s.statuses))-1]
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"releaseDate":"16.07.2006","text":"verse","link":"https://example.com"}`))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func TestInfoRetriesServerErrors(t *testing.T) {
+	s := newStub(t, http.StatusInternalServerError, http.StatusTooManyRequests, http.StatusOK)
+	c := New(s.URL, Options{Retries: 2, Backoff: time.Millisecond})
+
+	detail, err := c.Info(context.Background(), "Muse", "Uprising")
+	if err != nil {
+		t.Fatalf("Info: %v", err)
+	}
+	if detail.ReleaseDate != "16.07.2006" {
+		t.Errorf("release date = %q, want 16.07.2006", detail.ReleaseDate)
+	}
+	if got := s.calls.Load(); got != 3 {
+		t.Errorf("calls = %d, want 3", got)
+	}
+}
+
+func TestInfoGivesUpAfterRetries(t *testing.T) {
+	s := newStub(t, http.StatusBadGateway)
+	c := New(s.URL, Options{Retries: 2, Backoff: time.Millisecond})
+
+	if _, err := c.Info(context.Background(), "Muse", "Uprising"); err == nil {
+		t.Fatal("Info succeeded, want error")
+	}
+	if got := s.calls.Load(); got != 3 {
+		t.Errorf("calls = %d, want 3", got)
+	}
+}
+
+func TestInfoDoesNotRetryClientErrors(t *testing.T) {
+	for _, tc := range []struct {
+		status int
+		want   error
+	}{
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusBadRequest, nil},
+	} {
+		s := newStub(t, tc.status, http.StatusOK)
+		c := New(s.URL, Options{Retries: 3, Backoff: time.Millisecond, FailureThreshold: 1, OpenTimeout: time.Hour})
+
+		_, err := c.Info(context.Background(), "Muse", "Uprising")
+		if err == nil || (tc.want != nil && !errors.Is(err, tc.want)) {
+			t.Errorf("status %d: error = %v, want %v", tc.status, err, tc.want)
+		}
+		if got := s.calls.Load(); got != 1 {
+			t.Errorf("status %d: calls = %d, want 1", tc.status, got)
+		}
+		// Ответ 4xx не размыкает автомат защиты.
+		if _, err = c.Info(context.Background(), "Muse", "Uprising"); err != nil {
+			t.Errorf("status %d: second call: %v", tc.status, err)
+		}
+	}
+}
+
+func TestInfoBackoffCancelled(t *testing.T) {
+	s := newStub(t, http.StatusServiceUnavailable)
+	c := New(s.URL, Options{Retries: 5, Backoff: time.Hour})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := c.Info(ctx, "Muse", "Uprising")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("error = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Info returned after %v, want it to stop waiting on cancellation", elapsed)
+	}
+	if got := s.calls.Load(); got != 1 {
+		t.Errorf("calls = %d, want 1", got)
+	}
+}
+
+func TestInfoDeadline(t *testing.T) {
+	s := newStub(t, http.StatusServiceUnavailable)
+	c := New(s.URL, Options{Retries: 5, Backoff: time.Hour, Deadline: 50 * time.Millisecond})
+
+	start := time.Now()
+	_, err := c.Info(context.Background(), "Muse", "Uprising")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("error = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Info returned after %v, want it to stop at the deadline", elapsed)
+	}
+}
+
+func TestBreakerOpensAndCloses(t *testing.T) {
+	s := newStub(t, http.StatusInternalServerError, http.StatusInternalServerError, http.StatusOK)
+	c := New(s.URL, Options{FailureThreshold: 2, OpenTimeout: 50 * time.Millisecond})
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if _, err := c.Info(ctx, "Muse", "Uprising"); err == nil || errors.Is(err, ErrCircuitOpen) {
+			t.Fatalf("call %d: error = %v, want service error", i, err)
+		}
+	}
+	// Разомкнут: запрос не доходит до сервиса.
+	if _, err := c.Info(ctx, "Muse", "Uprising"); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("open: error = %v, want ErrCircuitOpen", err)
+	}
+	if got := s.calls.Load(); got != 2 {
+		t.Fatalf("calls = %d, want 2", got)
+	}
+
+	// Полуоткрыт: пробный запрос успешен и замыкает автомат.
+	time.Sleep(60 * time.Millisecond)
+	if _, err := c.Info(ctx, "Muse", "Uprising"); err != nil {
+		t.Fatalf("probe: %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := c.Info(ctx, "Muse", "Uprising"); err != nil {
+			t.Fatalf("closed, call %d: %v", i, err)
+		}
+	}
+	if got := s.calls.Load(); got != 6 {
+		t.Errorf("calls = %d, want 6", got)
+	}
+}
+
+func TestBreakerProbeCancelledInBackoff(t *testing.T) {
+	s := newStub(t, http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK)
+	c := New(s.URL, Options{Retries: 1, Backoff: time.Hour, FailureThreshold: 1, OpenTimeout: 50 * time.Millisecond})
+	call := func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		_, err := c.Info(ctx, "Muse", "Uprising")
+		return err
+	}
+
+	if err := call(); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("first call: error = %v, want context.DeadlineExceeded", err)
+	}
+	if err := call(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("open: error = %v, want ErrCircuitOpen", err)
+	}
+
+	// Пробный запрос отменяется во время задержки перед повтором: автомат снова размыкается,
+	// а не остаётся навсегда в ожидании исхода пробы.
+	time.Sleep(60 * time.Millisecond)
+	if err := call(); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("probe: error = %v, want context.DeadlineExceeded", err)
+	}
+	if err := call(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("reopened: error = %v, want ErrCircuitOpen", err)
+	}
+
+	time.Sleep(60 * time.Millisecond)
+	if err := call(); err != nil {
+		t.Fatalf("second probe: %v", err)
+	}
+	if got := s.calls.Load(); got != 3 {
+		t.Errorf("calls = %d, want 3", got)
+	}
+}
+
+func TestBreakerIgnoresCancelledCalls(t *testing.T) {
+	s := newStub(t, http.StatusServiceUnavailable, http.StatusOK)
+	c := New(s.URL, Options{Retries: 1, Backoff: time.Hour, FailureThreshold: 1, OpenTimeout: time.Hour})
+
+	// Клиент API отменил запрос во время задержки перед повтором: это не сбой сервиса.
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+	if _, err := c.Info(ctx, "Muse", "Uprising"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("cancelled call: error = %v, want context.Canceled", err)
+	}
+
+	if _, err := c.Info(context.Background(), "Muse", "Uprising"); err != nil {
+		t.Fatalf("next call: %v", err)
+	}
+	if got := s.calls.Load(); got != 2 {
+		t.Errorf("calls = %d, want 2", got)
+	}
+}
@@ -0,0 +1,43 @@
+package storage
+
+import (
+	"Music_Library/config"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+)
+
+var ErrNotFound = errors.New("blob not found")
+
+// Store хранит бинарные файлы (аудио, обложки) по ключу.
+type Store interface {
+	// Put сохраняет содержимое r под ключом key.
+	Put(key string, r io.Reader) error
+	// Open открывает файл для чтения с произвольным доступом.
+	Open(key string) (io.ReadSeekCloser, error)
+	// Exists проверяет, есть ли файл с таким ключом.
+	Exists(key string) (bool, error)
+	// Delete удаляет файл. Удаление отсутствующего файла не считается ошибкой.
+	Delete(key string) error
+	// Ping проверяет, что хранилище доступно для записи.
+	Ping() error
+}
+
+var Blobs Store
+
+func SetupStorage(log *slog.Logger, cfg *config.Config) error {
+	switch cfg.Blob.Driver {
+	case "local":
+		store, err := NewLocalStore(cfg.Blob.Path)
+		if err != nil {
+			log.Error("Failed to set up local blob storage", "path", cfg.Blob.Path, "error", err)
+			return err
+		}
+		Blobs = store
+		log.Info("Local blob storage ready", "path", cfg.Blob.Path)
+		return nil
+	default:
+		return fmt.Errorf("unknown blob storage driver %q", cfg.Blob.Driver)
+	}
+}
@@ -0,0 +1,93 @@
+package tags
+
+import (
+	"Music_Library/internal/models"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/dhowden/tag"
+)
+
+var ErrUnsupportedFormat = errors.New("unsupported audio format, only MP3 (ID3v2) and FLAC are accepted")
+
+// Metadata содержит теги, прочитанные из аудиофайла.
+type Metadata struct {
+	Artist string
+	Title  string
+	Year   int
+	Lyrics string
+}
+
+// Read читает теги ID3v2 из MP3 или Vorbis comment из FLAC.
+func Read(r io.ReadSeeker) (*Metadata, error) {
+	header := make([]byte, 4)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, ErrUnsupportedFormat
+	}
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	var (
+		m   tag.Metadata
+		err error
+	)
+	switch {
+	case string(header[:3]) == "ID3":
+		m, err = tag.ReadID3v2Tags(r)
+	case string(header) == "fLaC":
+		m, err = tag.ReadFLACTags(r)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	lyrics := m.Lyrics()
+	if lyrics == "" && m.FileType() == tag.FLAC {
+		// В FLAC несинхронизированный текст часто хранится под ключом UNSYNCEDLYRICS.
+		if v, ok := m.Raw()["unsyncedlyrics"].(string); ok {
+			lyrics = v
+		}
+	}
+
+	return &Metadata{
+		Artist: strings.TrimSpace(m.Artist()),
+		Title:  strings.TrimSpace(m.Title()),
+		Year:   m.Year(),
+		Lyrics: strings.TrimSpace(lyrics),
+	}, nil
+}
+
+// Song собирает песню из тегов и возвращает JSON-имена полей, взятых из файла.
+// Текст разбивается на куплеты по пустым строкам.
+func (m *Metadata) Song() (*models.Song, []string) {
+	song := &models.Song{Group: m.Artist, Title: m.Title}
+	fields := []string{"group", "title"}
+	if m.Year > 0 {
+		song.ReleaseDate = models.Year(m.Year)
+		fields = append(fields, "release_date")
+	}
+	for i, verse := range SplitVerses(m.Lyrics) {
+		song.Lyrics = append(song.Lyrics, models.Lyric{VerseNumber: i + 1, Text: verse})
+	}
+	if len(song.Lyrics) > 0 {
+		fields = append(fields, "lyrics")
+	}
+	return song, fields
+}
+
+// SplitVerses разбивает текст песни на куплеты по пустым строкам.
+func SplitVerses(text string) []string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	var verses []string
+	for _, block := range strings.Split(text, "\n\n") {
+		block = strings.TrimSpace(block)
+		if block != "" {
+			verses = append(verses, block)
+		}
+	}
+	return verses
+}
@@ -0,0 +1,92 @@
+package tracing
+
+import (
+	"errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+	"regexp"
+)
+
+const spanKey = "tracing:span"
+
+var tracer = otel.Tracer("Music_Library/internal/tracing")
+
+type registerer interface {
+	Register(name string, fn func(*gorm.DB)) error
+}
+
+// InstrumentGORM добавляет колбэки, которые оборачивают каждый запрос GORM в span
+// с текстом SQL без значений параметров.
+func InstrumentGORM(db *gorm.DB) error {
+	cb := db.Callback()
+	register := func(operation string, before, after registerer) error {
+		if err := before.Register("tracing:before_"+operation, startSpan(operation)); err != nil {
+			return err
+		}
+		return after.Register("tracing:after_"+operation, endSpan)
+	}
+
+	return errors.Join(
+		register("create", cb.Create().Before("gorm:create"), cb.Create().After("gorm:create")),
+		register("query", cb.Query().Before("gorm:query"), cb.Query().After("gorm:query")),
+		register("update", cb.Update().Before("gorm:update"), cb.Update().After("gorm:update")),
+		register("delete", cb.Delete().Before("gorm:delete"), cb.Delete().After("gorm:delete")),
+		register("row", cb.Row().Before("gorm:row"), cb.Row().After("gorm:row")),
+		register("raw", cb.Raw().Before("gorm:raw"), cb.Raw().After("gorm:raw")),
+	)
+}
+
+func startSpan(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		_, span := tracer.Start(db.Statement.Context, "gorm."+operation,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(semconv.DBSystemPostgreSQL, semconv.DBOperationName(operation)),
+		)
+		db.InstanceSet(spanKey, span)
+	}
+}
+
+func endSpan(db *gorm.DB) {
+	value, ok := db.InstanceGet(spanKey)
+	if !ok {
+		return
+	}
+	span, ok := value.(trace.Span)
+	if !ok {
+		return
+	}
+	defer span.End()
+
+	if db.Statement.Table != "" {
+		span.SetAttributes(semconv.DBCollectionName(db.Statement.Table))
+	}
+	span.SetAttributes(
+		semconv.DBQueryText(Redact(db.Statement.SQL.String())),
+		attribute.Int64("db.response.rows_affected", db.Statement.RowsAffected),
+	)
+	if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+		span.RecordError(db.Error)
+		span.SetStatus(codes.Error, db.Error.Error())
+	}
+}
+
+var (
+	stringLiteral  = regexp.MustCompile(`'(?:[^']|'')*'`)
+	numericLiteral = regexp.MustCompile(`\$?\b\d+(?:\.\d+)?\b`)
+)
+
+// Redact заменяет строковые и числовые литералы в SQL на "?". Значения из параметров
+// ($1, $2, ...) в текст запроса GORM и так не попадают, а литералы бывают в Raw-запросах.
+func Redact(sql string) string {
+	sql = stringLiteral.ReplaceAllString(sql, "'?'")
+	return numericLiteral.ReplaceAllStringFunc(sql, func(s string) string {
+		if s[0] == '$' {
+			return s
+		}
+		return "?"
+	})
+}
@@ -0,0 +1,46 @@
+// Package apierror сопоставляет ошибки репозитория и запроса с ответами клиенту,
+// одинаково для HTTP-обработчиков и gRPC-сервера.
+package apierror
+
+import (
+	"Music_Library/internal/database/postgres"
+	"Music_Library/internal/models"
+	"errors"
+	"net/http"
+)
+
+// statusError — ошибка, для которой вызывающий уже знает статус HTTP, например неприменимый патч.
+type statusError interface {
+	error
+	Status() int
+}
+
+// Describe возвращает статус HTTP, код, текст и ошибки полей, которые клиент получит для err.
+// Отсутствующая запись — 404, конфликт — 409, ошибка валидации — 400 с перечнем полей,
+// остальные ошибки — 500 без их текста: его нужно только залогировать.
+func Describe(err error) (status int, code, detail string, fields []models.FieldError) {
+	status, code = classify(err)
+	if status >= http.StatusInternalServerError {
+		return status, code, "internal server error", nil
+	}
+	var validationErr *postgres.ValidationError
+	if errors.As(err, &validationErr) {
+		fields = validationErr.Fields
+	}
+	return status, code, err.Error(), fields
+}
+
+func classify(err error) (int, string) {
+	var withStatus statusError
+	switch {
+	case errors.As(err, &withStatus):
+		return withStatus.Status(), models.StatusCode(withStatus.Status())
+	case errors.Is(err, postgres.ErrNotFound):
+		return http.StatusNotFound, models.CodeNotFound
+	case errors.Is(err, postgres.ErrConflict):
+		return http.StatusConflict, models.CodeConflict
+	case errors.Is(err, postgres.ErrValidation):
+		return http.StatusBadRequest, models.CodeValidationFailed
+	}
+	return http.StatusInternalServerError, models.CodeInternal
+}
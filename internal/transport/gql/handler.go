@@ -0,0 +1,73 @@
+package gql
+
+import (
+	"Music_Library/internal/models"
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/graphql-go/graphql"
+	"log/slog"
+	"net/http"
+)
+
+type request struct {
+	Query         string                 `json:"query"`
+	Variables     map[string]interface{} `json:"variables"`
+	OperationName string                 `json:"operationName"`
+}
+
+// Handle godoc
+//
+//	@Summary		GraphQL endpoint
+//	@Description	Executes a GraphQL query or mutation over songs, lyrics and artists. Queries deeper than 8 levels or with complexity over 5000 are rejected; list fields count as many items as their pageSize (10 by default).
+//	@Tags			graphql
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		object					true	"GraphQL request with query, variables and operationName"
+//	@Success		200		{object}	object					"GraphQL response with data and errors"
+//	@Failure		400		{object}	models.ErrorResponse	"Invalid request or query over the limits"
+//	@Router			/graphql [post]
+func Handle(c *gin.Context, logger *slog.Logger) {
+	var req request
+	if c.Request.Method == http.MethodGet {
+		req.Query = c.Query("query")
+		req.OperationName = c.Query("operationName")
+		if vars := c.Query("variables"); vars != "" {
+			if err := json.Unmarshal([]byte(vars), &req.Variables); err != nil {
+				logger.Warn("Invalid GraphQL variables", "error", err)
+				models.NewErrorResponse(c, 400, err.Error())
+				return
+			}
+		}
+	} else if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Invalid GraphQL request", "error", err)
+		models.NewErrorResponse(c, 400, err.Error())
+		return
+	}
+	if req.Query == "" {
+		models.NewErrorResponse(c, 400, "query is required")
+		return
+	}
+	if c.Request.Method == http.MethodGet && hasMutation(req.Query) {
+		models.NewErrorResponse(c, 405, "mutations must be sent with POST")
+		return
+	}
+	if err := checkLimits(req.Query, req.Variables); err != nil {
+		logger.Warn("GraphQL query rejected", "error", err)
+		models.NewErrorResponse(c, 400, err.Error())
+		return
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema:         Schema,
+		RequestString:  req.Query,
+		VariableValues: req.Variables,
+		OperationName:  req.OperationName,
+		Context:        withLoaders(c.Request.Context()),
+	})
+	if result.HasErrors() {
+		logger.Warn("GraphQL request finished with errors", "errors", result.Errors)
+	} else {
+		logger.Info("GraphQL request executed", "operation", req.OperationName)
+	}
+	c.JSON(http.StatusOK, result)
+}
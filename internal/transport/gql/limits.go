@@ -0,0 +1,162 @@
+package gql
+
+import (
+	"fmt"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+)
+
+const (
+	defaultPageSize = 10
+	maxLimit        = 100
+	maxDepth        = 8
+	maxComplexity   = 5000
+)
+
+// listFields — поля, которые возвращают список без пагинации. Их стоимость
+// умножается на defaultPageSize, а стоимость полей с аргументом pageSize или limit — на его значение.
+var listFields = map[string]bool{
+	"songs":   true,
+	"lyrics":  true,
+	"artwork": true,
+}
+
+// checkLimits отклоняет запросы глубже maxDepth или дороже maxComplexity
+// до того, как они попадут в базу данных.
+func checkLimits(query string, variables map[string]interface{}) error {
+	doc, err := parser.Parse(parser.ParseParams{Source: query})
+	if err != nil {
+		// Синтаксические ошибки сообщит graphql.Do.
+		return nil
+	}
+
+	fragments := make(map[string]*ast.FragmentDefinition)
+	for _, def := range doc.Definitions {
+		if f, ok := def.(*ast.FragmentDefinition); ok {
+			fragments[f.Name.Value] = f
+		}
+	}
+
+	for _, def := range doc.Definitions {
+		op, ok := def.(*ast.OperationDefinition)
+		if !ok {
+			continue
+		}
+		a := analyzer{fragments: fragments, variables: withDefaults(op, variables)}
+		depth, complexity := a.selectionSet(op.SelectionSet, 0, map[string]bool{})
+		if depth > maxDepth {
+			return fmt.Errorf("query depth %d exceeds the limit of %d", depth, maxDepth)
+		}
+		if complexity > maxComplexity {
+			return fmt.Errorf("query complexity %d exceeds the limit of %d", complexity, maxComplexity)
+		}
+	}
+	return nil
+}
+
+type analyzer struct {
+	fragments map[string]*ast.FragmentDefinition
+	variables map[string]interface{}
+}
+
+// selectionSet возвращает глубину и стоимость набора полей.
+func (a analyzer) selectionSet(set *ast.SelectionSet, depth int, visited map[string]bool) (int, int) {
+	if set == nil {
+		return depth, 0
+	}
+	maxD, total := depth, 0
+	for _, sel := range set.Selections {
+		var d, c int
+		switch s := sel.(type) {
+		case *ast.Field:
+			d, c = a.selectionSet(s.SelectionSet, depth+1, visited)
+			if s.SelectionSet != nil {
+				c *= a.multiplier(s)
+			}
+			c++
+		case *ast.InlineFragment:
+			d, c = a.selectionSet(s.SelectionSet, depth, visited)
+		case *ast.FragmentSpread:
+			name := s.Name.Value
+			if visited[name] || a.fragments[name] == nil {
+				continue
+			}
+			visited[name] = true
+			d, c = a.selectionSet(a.fragments[name].SelectionSet, depth, visited)
+			delete(visited, name)
+		}
+		maxD = max(maxD, d)
+		total += c
+	}
+	return maxD, total
+}
+
+// multiplier возвращает, сколько раз считать стоимость полей внутри списка. Размер страницы
+// вне 1..maxLimit резолвер отклонит, но до этого запрос оценивается как самый дорогой.
+func (a analyzer) multiplier(field *ast.Field) int {
+	for _, arg := range field.Arguments {
+		if arg.Name.Value != "pageSize" && arg.Name.Value != "limit" {
+			continue
+		}
+		var value interface{} = arg.Value
+		if v, ok := value.(*ast.Variable); ok {
+			if value, ok = a.variables[v.Name.Value]; !ok {
+				// Переменную не передали, поэтому резолвер возьмёт значение аргумента по умолчанию.
+				return defaultPageSize
+			}
+		}
+		if n, ok := intValue(value); ok && n > 0 && n <= maxLimit {
+			return n
+		}
+		return maxLimit
+	}
+	if listFields[field.Name.Value] {
+		return defaultPageSize
+	}
+	return 1
+}
+
+// withDefaults дополняет переменные запроса значениями по умолчанию из объявления операции.
+func withDefaults(op *ast.OperationDefinition, variables map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(variables))
+	for name, v := range variables {
+		result[name] = v
+	}
+	for _, def := range op.VariableDefinitions {
+		name := def.Variable.Name.Value
+		if _, ok := result[name]; !ok && def.DefaultValue != nil {
+			result[name] = def.DefaultValue
+		}
+	}
+	return result
+}
+
+// intValue читает целое из литерала запроса или из значения переменной в JSON.
+func intValue(value interface{}) (int, bool) {
+	switch v := value.(type) {
+	case *ast.IntValue:
+		var n int
+		if _, err := fmt.Sscan(v.Value, &n); err == nil {
+			return n, true
+		}
+	case float64:
+		if v == float64(int(v)) {
+			return int(v), true
+		}
+	}
+	return 0, false
+}
+
+// hasMutation сообщает, есть ли в документе мутации.
+func hasMutation(query string) bool {
+	doc, err := parser.Parse(parser.ParseParams{Source: query})
+	if err != nil {
+		return false
+	}
+	for _, def := range doc.Definitions {
+		if op, ok := def.(*ast.OperationDefinition); ok && op.Operation == ast.OperationTypeMutation {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,125 @@
+package gql
+
+import (
+	"Music_Library/internal/database/postgres"
+	"Music_Library/internal/models"
+	"context"
+)
+
+// thunk — отложенное значение поля. graphql-go сначала собирает все thunk'и
+// одного уровня запроса и только потом вычисляет их, поэтому загрузчик успевает
+// накопить ключи и получить данные одним запросом.
+type thunk = func() (interface{}, error)
+
+// loader накапливает ключи и загружает значения для всех них разом.
+type loader[K comparable, V any] struct {
+	fetch   func(keys []K) (map[K]V, error)
+	pending []K
+	cache   map[K]V
+	err     error
+}
+
+func newLoader[K comparable, V any](fetch func(keys []K) (map[K]V, error)) *loader[K, V] {
+	return &loader[K, V]{fetch: fetch, cache: make(map[K]V)}
+}
+
+func (l *loader[K, V]) load(key K) thunk {
+	if _, ok := l.cache[key]; !ok {
+		l.pending = append(l.pending, key)
+	}
+	return func() (interface{}, error) {
+		if len(l.pending) > 0 {
+			keys := l.pending
+			l.pending = nil
+			values, err := l.fetch(keys)
+			if err != nil {
+				l.err = err
+			}
+			for _, k := range keys {
+				l.cache[k] = values[k]
+			}
+		}
+		if l.err != nil {
+			return nil, l.err
+		}
+		return l.cache[key], nil
+	}
+}
+
+// loaders живут в пределах одного запроса.
+type loaders struct {
+	lyrics  *loader[uint, []models.Lyric]
+	artwork *loader[uint, []models.Artwork]
+	songs   *loader[uint, *models.Song]
+	groups  *loader[groupPage, []models.Song]
+}
+
+// groupPage — страница песен группы в поле Artist.songs.
+type groupPage struct {
+	group         string
+	offset, limit int
+}
+
+type loadersKey struct{}
+
+func withLoaders(ctx context.Context) context.Context {
+	return context.WithValue(ctx, loadersKey{}, &loaders{
+		lyrics: newLoader(func(ids []uint) (map[uint][]models.Lyric, error) {
+			lyrics, err := postgres.GetLyricsBySongIDs(ctx, ids)
+			result := make(map[uint][]models.Lyric, len(ids))
+			for _, id := range ids {
+				result[id] = []models.Lyric{}
+			}
+			for _, l := range lyrics {
+				result[l.SongID] = append(result[l.SongID], l)
+			}
+			return result, err
+		}),
+		artwork: newLoader(func(ids []uint) (map[uint][]models.Artwork, error) {
+			artwork, err := postgres.GetArtworkBySongIDs(ctx, ids)
+			result := make(map[uint][]models.Artwork, len(ids))
+			for _, id := range ids {
+				result[id] = []models.Artwork{}
+			}
+			for _, a := range artwork {
+				result[a.SongID] = append(result[a.SongID], a)
+			}
+			return result, err
+		}),
+		songs: newLoader(func(ids []uint) (map[uint]*models.Song, error) {
+			songs, err := postgres.GetSongsByIDs(ctx, ids)
+			result := make(map[uint]*models.Song, len(songs))
+			for i := range songs {
+				result[songs[i].ID] = &songs[i]
+			}
+			return result, err
+		}),
+		groups: newLoader(func(keys []groupPage) (map[groupPage][]models.Song, error) {
+			// Группы с одинаковыми offset и limit загружаются одним запросом.
+			byPage := make(map[groupPage][]string)
+			for _, k := range keys {
+				page := groupPage{offset: k.offset, limit: k.limit}
+				byPage[page] = append(byPage[page], k.group)
+			}
+			result := make(map[groupPage][]models.Song, len(keys))
+			for _, k := range keys {
+				result[k] = []models.Song{}
+			}
+			for page, groups := range byPage {
+				songs, err := postgres.GetSongsByGroups(ctx, groups, page.offset, page.limit)
+				if err != nil {
+					return result, err
+				}
+				for _, s := range songs {
+					k := groupPage{group: s.Group, offset: page.offset, limit: page.limit}
+					result[k] = append(result[k], s)
+				}
+			}
+			return result, nil
+		}),
+	})
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
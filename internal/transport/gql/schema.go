@@ -0,0 +1,468 @@
+package gql
+
+import (
+	"Music_Library/internal/database/postgres"
+	"Music_Library/internal/models"
+	"errors"
+	"fmt"
+	"github.com/graphql-go/graphql"
+)
+
+var (
+	lyricType   *graphql.Object
+	songType    *graphql.Object
+	artworkType *graphql.Object
+	artistType  *graphql.Object
+
+	// Schema — схема GraphQL API, построенная поверх models.Song и models.Lyric.
+	Schema graphql.Schema
+)
+
+func init() {
+	artworkType = graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Artwork",
+		Description: "One size of a song's cover art",
+		Fields: graphql.Fields{
+			"size":        {Type: graphql.NewNonNull(graphql.String), Resolve: artworkField(func(a *models.Artwork) any { return a.Size })},
+			"width":       {Type: graphql.NewNonNull(graphql.Int), Resolve: artworkField(func(a *models.Artwork) any { return a.Width })},
+			"height":      {Type: graphql.NewNonNull(graphql.Int), Resolve: artworkField(func(a *models.Artwork) any { return a.Height })},
+			"contentType": {Type: graphql.NewNonNull(graphql.String), Resolve: artworkField(func(a *models.Artwork) any { return a.ContentType })},
+			"url":         {Type: graphql.NewNonNull(graphql.String), Resolve: artworkField(func(a *models.Artwork) any { return a.URL })},
+		},
+	})
+
+	lyricType = graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Lyric",
+		Description: "A verse of a song",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id":          {Type: graphql.NewNonNull(graphql.Int), Resolve: lyricField(func(l *models.Lyric) any { return l.ID })},
+				"songId":      {Type: graphql.NewNonNull(graphql.Int), Resolve: lyricField(func(l *models.Lyric) any { return l.SongID })},
+				"verseNumber": {Type: graphql.NewNonNull(graphql.Int), Resolve: lyricField(func(l *models.Lyric) any { return l.VerseNumber })},
+				"text":        {Type: graphql.NewNonNull(graphql.String), Resolve: lyricField(func(l *models.Lyric) any { return l.Text })},
+				"song": {
+					Type: songType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return loadersFrom(p.Context).songs.load(asLyric(p.Source).SongID), nil
+					},
+				},
+			}
+		}),
+	})
+
+	songType = graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Song",
+		Description: "A song with its lyrics",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id":          {Type: graphql.NewNonNull(graphql.Int), Resolve: songField(func(s *models.Song) any { return s.ID })},
+				"group":       {Type: graphql.NewNonNull(graphql.String), Resolve: songField(func(s *models.Song) any { return s.Group })},
+				"title":       {Type: graphql.NewNonNull(graphql.String), Resolve: songField(func(s *models.Song) any { return s.Title })},
+				"releaseDate": {Type: graphql.String, Resolve: songField(func(s *models.Song) any { return releaseDate(s.ReleaseDate) })},
+				"link":        {Type: graphql.String, Resolve: songField(func(s *models.Song) any { return s.Link })},
+				"lyrics": {
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(lyricType))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return loadersFrom(p.Context).lyrics.load(asSong(p.Source).ID), nil
+					},
+				},
+				"artwork": {
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(artworkType))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return loadersFrom(p.Context).artwork.load(asSong(p.Source).ID), nil
+					},
+				},
+				"artist": {
+					Type: graphql.NewNonNull(artistType),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return asSong(p.Source).Group, nil
+					},
+				},
+			}
+		}),
+	})
+
+	artistType = graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Artist",
+		Description: "A group that performs songs",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"name": {
+					Type: graphql.NewNonNull(graphql.String),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(string), nil
+					},
+				},
+				"songs": {
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(songType))),
+					Args: graphql.FieldConfigArgument{
+						"offset": {Type: graphql.Int, DefaultValue: 0},
+						"limit":  {Type: graphql.Int, DefaultValue: defaultPageSize},
+					},
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						offset, limit, err := pageArgs(p.Args, "limit")
+						if err != nil {
+							return nil, err
+						}
+						return loadersFrom(p.Context).groups.load(groupPage{group: p.Source.(string), offset: offset, limit: limit}), nil
+					},
+				},
+			}
+		}),
+	})
+
+	var err error
+	Schema, err = graphql.NewSchema(graphql.SchemaConfig{Query: newQueryType(), Mutation: newMutationType()})
+	if err != nil {
+		panic(err)
+	}
+}
+
+func newSongPageType() *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: "SongPage",
+		Fields: graphql.Fields{
+			"items":    {Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(songType)))},
+			"total":    {Type: graphql.NewNonNull(graphql.Int)},
+			"offset":   {Type: graphql.NewNonNull(graphql.Int)},
+			"pageSize": {Type: graphql.NewNonNull(graphql.Int)},
+		},
+	})
+}
+
+func newArtistPageType() *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: "ArtistPage",
+		Fields: graphql.Fields{
+			"items":    {Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(artistType)))},
+			"total":    {Type: graphql.NewNonNull(graphql.Int)},
+			"offset":   {Type: graphql.NewNonNull(graphql.Int)},
+			"pageSize": {Type: graphql.NewNonNull(graphql.Int)},
+		},
+	})
+}
+
+var verseInputType = graphql.NewInputObject(graphql.InputObjectConfig{
+	Name:        "VerseInput",
+	Description: "A verse created together with its song",
+	Fields: graphql.InputObjectConfigFieldMap{
+		"verseNumber": {Type: graphql.NewNonNull(graphql.Int)},
+		"text":        {Type: graphql.NewNonNull(graphql.String)},
+	},
+})
+
+var songInputType = graphql.NewInputObject(graphql.InputObjectConfig{
+	Name: "SongInput",
+	Fields: graphql.InputObjectConfigFieldMap{
+		"group":       {Type: graphql.NewNonNull(graphql.String)},
+		"title":       {Type: graphql.NewNonNull(graphql.String)},
+		"releaseDate": {Type: graphql.String},
+		"link":        {Type: graphql.String},
+		"lyrics":      {Type: graphql.NewList(graphql.NewNonNull(verseInputType))},
+	},
+})
+
+var songUpdateInputType = graphql.NewInputObject(graphql.InputObjectConfig{
+	Name:        "SongUpdateInput",
+	Description: "Fields to change; omitted fields keep their values",
+	Fields: graphql.InputObjectConfigFieldMap{
+		"group":       {Type: graphql.String},
+		"title":       {Type: graphql.String},
+		"releaseDate": {Type: graphql.String},
+		"link":        {Type: graphql.String},
+	},
+})
+
+var lyricInputType = graphql.NewInputObject(graphql.InputObjectConfig{
+	Name: "LyricInput",
+	Fields: graphql.InputObjectConfigFieldMap{
+		"songId":      {Type: graphql.NewNonNull(graphql.Int)},
+		"verseNumber": {Type: graphql.NewNonNull(graphql.Int)},
+		"text":        {Type: graphql.NewNonNull(graphql.String)},
+	},
+})
+
+var lyricUpdateInputType = graphql.NewInputObject(graphql.InputObjectConfig{
+	Name:        "LyricUpdateInput",
+	Description: "Fields to change; omitted fields keep their values",
+	Fields: graphql.InputObjectConfigFieldMap{
+		"songId":      {Type: graphql.Int},
+		"verseNumber": {Type: graphql.Int},
+		"text":        {Type: graphql.String},
+	},
+})
+
+func newQueryType() *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"song": {
+				Type: songType,
+				Args: graphql.FieldConfigArgument{"id": {Type: graphql.NewNonNull(graphql.Int)}},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					// Куплеты и обложки загрузят поля lyrics и artwork, если их запросят.
+					return notFoundAsNull(postgres.GetSongWithFields(p.Context, uint(p.Args["id"].(int)), postgres.SongFields{}))
+				},
+			},
+			"songs": {
+				Type:        graphql.NewNonNull(newSongPageType()),
+				Description: "Songs filtered like GET /songs",
+				Args: graphql.FieldConfigArgument{
+					"ids":            {Type: graphql.NewList(graphql.NewNonNull(graphql.Int))},
+					"group":          {Type: graphql.String},
+					"title":          {Type: graphql.String},
+					"releaseDate":    {Type: graphql.String},
+					"releasedAfter":  {Type: graphql.String},
+					"releasedBefore": {Type: graphql.String},
+					"year":           {Type: graphql.Int},
+					"link":           {Type: graphql.String},
+					"sort":           {Type: graphql.String, Description: `Fields like GET /songs?sort, e.g. "-release_date,title"`},
+					"offset":         {Type: graphql.Int, DefaultValue: 0},
+					"pageSize":       {Type: graphql.Int, DefaultValue: defaultPageSize},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					offset, pageSize, err := pageArgs(p.Args, "pageSize")
+					if err != nil {
+						return nil, err
+					}
+					filter := postgres.SongFilter{
+						IDs:            idsArg(p.Args, "ids"),
+						Group:          stringArg(p.Args, "group"),
+						Title:          stringArg(p.Args, "title"),
+						Link:           stringArg(p.Args, "link"),
+						ReleaseDate:    models.NewReleaseDate(stringArg(p.Args, "releaseDate")),
+						ReleasedAfter:  models.NewReleaseDate(stringArg(p.Args, "releasedAfter")),
+						ReleasedBefore: models.NewReleaseDate(stringArg(p.Args, "releasedBefore")),
+					}
+					if year, ok := p.Args["year"].(int); ok {
+						filter.Year = &year
+					}
+					order, err := postgres.ParseSongSort(stringArg(p.Args, "sort"))
+					if err != nil {
+						return nil, err
+					}
+					songs, total, err := postgres.GetAllSongs(p.Context, filter, order, postgres.SongFields{}, offset, pageSize)
+					if err != nil {
+						return nil, err
+					}
+					return map[string]interface{}{"items": songs, "total": total, "offset": offset, "pageSize": pageSize}, nil
+				},
+			},
+			"lyric": {
+				Type: lyricType,
+				Args: graphql.FieldConfigArgument{"id": {Type: graphql.NewNonNull(graphql.Int)}},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return notFoundAsNull(postgres.GetLyric(p.Context, uint(p.Args["id"].(int))))
+				},
+			},
+			"artists": {
+				Type: graphql.NewNonNull(newArtistPageType()),
+				Args: graphql.FieldConfigArgument{
+					"offset":   {Type: graphql.Int, DefaultValue: 0},
+					"pageSize": {Type: graphql.Int, DefaultValue: defaultPageSize},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					offset, pageSize, err := pageArgs(p.Args, "pageSize")
+					if err != nil {
+						return nil, err
+					}
+					groups, total, err := postgres.GetGroups(p.Context, offset, pageSize)
+					if err != nil {
+						return nil, err
+					}
+					return map[string]interface{}{"items": groups, "total": total, "offset": offset, "pageSize": pageSize}, nil
+				},
+			},
+		},
+	})
+}
+
+func newMutationType() *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: "Mutation",
+		Fields: graphql.Fields{
+			"createSong": {
+				Type: graphql.NewNonNull(songType),
+				Args: graphql.FieldConfigArgument{"input": {Type: graphql.NewNonNull(songInputType)}},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					input := p.Args["input"].(map[string]interface{})
+					song := models.Song{
+						Group:       input["group"].(string),
+						Title:       input["title"].(string),
+						ReleaseDate: models.NewReleaseDate(stringArg(input, "releaseDate")),
+						Link:        stringArg(input, "link"),
+					}
+					if verses, ok := input["lyrics"].([]interface{}); ok {
+						for _, v := range verses {
+							verse := v.(map[string]interface{})
+							song.Lyrics = append(song.Lyrics, models.Lyric{
+								VerseNumber: verse["verseNumber"].(int),
+								Text:        verse["text"].(string),
+							})
+						}
+					}
+					if err := postgres.AddSong(p.Context, &song); err != nil {
+						return nil, err
+					}
+					return &song, nil
+				},
+			},
+			"updateSong": {
+				Type: graphql.NewNonNull(songType),
+				Args: graphql.FieldConfigArgument{
+					"id":    {Type: graphql.NewNonNull(graphql.Int)},
+					"input": {Type: graphql.NewNonNull(songUpdateInputType)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					input := p.Args["input"].(map[string]interface{})
+					update := models.Song{
+						Group:       stringArg(input, "group"),
+						Title:       stringArg(input, "title"),
+						ReleaseDate: models.NewReleaseDate(stringArg(input, "releaseDate")),
+						Link:        stringArg(input, "link"),
+					}
+					return postgres.UpdateSong(p.Context, uint(p.Args["id"].(int)), &update)
+				},
+			},
+			"deleteSong": {
+				Type:        graphql.NewNonNull(graphql.Int),
+				Description: "Deletes a song with its lyrics and returns its ID",
+				Args:        graphql.FieldConfigArgument{"id": {Type: graphql.NewNonNull(graphql.Int)}},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					id := p.Args["id"].(int)
+					return id, postgres.DeleteSong(p.Context, uint(id))
+				},
+			},
+			"createLyric": {
+				Type: graphql.NewNonNull(lyricType),
+				Args: graphql.FieldConfigArgument{"input": {Type: graphql.NewNonNull(lyricInputType)}},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					input := p.Args["input"].(map[string]interface{})
+					lyric := models.Lyric{
+						SongID:      uint(input["songId"].(int)),
+						VerseNumber: input["verseNumber"].(int),
+						Text:        input["text"].(string),
+					}
+					if err := postgres.AddLyric(p.Context, &lyric); err != nil {
+						return nil, err
+					}
+					return &lyric, nil
+				},
+			},
+			"updateLyric": {
+				Type: graphql.NewNonNull(lyricType),
+				Args: graphql.FieldConfigArgument{
+					"id":    {Type: graphql.NewNonNull(graphql.Int)},
+					"input": {Type: graphql.NewNonNull(lyricUpdateInputType)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					input := p.Args["input"].(map[string]interface{})
+					update := models.Lyric{Text: stringArg(input, "text")}
+					if songID, ok := input["songId"].(int); ok {
+						update.SongID = uint(songID)
+					}
+					if verse, ok := input["verseNumber"].(int); ok {
+						update.VerseNumber = verse
+					}
+					return postgres.UpdateLyric(p.Context, uint(p.Args["id"].(int)), &update)
+				},
+			},
+			"deleteLyric": {
+				Type:        graphql.NewNonNull(graphql.Int),
+				Description: "Deletes a verse and returns its ID",
+				Args:        graphql.FieldConfigArgument{"id": {Type: graphql.NewNonNull(graphql.Int)}},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					id := p.Args["id"].(int)
+					return id, postgres.DeleteLyric(p.Context, uint(id))
+				},
+			},
+		},
+	})
+}
+
+func asSong(src interface{}) *models.Song {
+	switch s := src.(type) {
+	case *models.Song:
+		return s
+	case models.Song:
+		return &s
+	}
+	return &models.Song{}
+}
+
+func asLyric(src interface{}) *models.Lyric {
+	switch l := src.(type) {
+	case *models.Lyric:
+		return l
+	case models.Lyric:
+		return &l
+	}
+	return &models.Lyric{}
+}
+
+func asArtwork(src interface{}) *models.Artwork {
+	switch a := src.(type) {
+	case *models.Artwork:
+		return a
+	case models.Artwork:
+		return &a
+	}
+	return &models.Artwork{}
+}
+
+func songField(get func(*models.Song) any) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) { return get(asSong(p.Source)), nil }
+}
+
+func lyricField(get func(*models.Lyric) any) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) { return get(asLyric(p.Source)), nil }
+}
+
+func artworkField(get func(*models.Artwork) any) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) { return get(asArtwork(p.Source)), nil }
+}
+
+// releaseDate отдаёт неизвестную дату выхода как null.
+func releaseDate(d models.ReleaseDate) any {
+	if d.IsZero() {
+		return nil
+	}
+	return d.String()
+}
+
+func stringArg(args map[string]interface{}, name string) string {
+	s, _ := args[name].(string)
+	return s
+}
+
+func idsArg(args map[string]interface{}, name string) []uint {
+	list, _ := args[name].([]interface{})
+	ids := make([]uint, 0, len(list))
+	for _, id := range list {
+		ids = append(ids, uint(id.(int)))
+	}
+	return ids
+}
+
+// pageArgs возвращает offset и размер страницы из аргумента size, проверяя их пределы.
+func pageArgs(args map[string]interface{}, size string) (int, int, error) {
+	offset, n := args["offset"].(int), args[size].(int)
+	if offset < 0 {
+		return 0, 0, errors.New("offset must not be negative")
+	}
+	if n <= 0 || n > maxLimit {
+		return 0, 0, fmt.Errorf("%s must be between 1 and %d", size, maxLimit)
+	}
+	return offset, n, nil
+}
+
+// notFoundAsNull превращает отсутствующую запись в null вместо ошибки.
+func notFoundAsNull[T any](value *T, err error) (interface{}, error) {
+	if err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return value, nil
+}
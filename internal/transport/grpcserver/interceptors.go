@@ -0,0 +1,135 @@
+package grpcserver
+
+import (
+	"Music_Library/internal/logging"
+	"Music_Library/internal/transport/apierror"
+	"context"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+	"strings"
+	"time"
+)
+
+// unaryInterceptor логирует вызовы так же, как HTTP-обработчики, переводит ошибки
+// репозитория в коды gRPC и, как recovery в HTTP-роутере, не даёт панике уронить сервер.
+func unaryInterceptor(log *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		start := time.Now()
+		ctx, logger := withRequestID(ctx, log)
+		defer func() {
+			if r := recover(); r != nil {
+				err = recovered(logger, info.FullMethod, r)
+			}
+			logCall(logger, info.FullMethod, start, err)
+			err = toStatus(err)
+		}()
+		return handler(ctx, req)
+	}
+}
+
+func streamInterceptor(log *slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		start := time.Now()
+		ctx, logger := withRequestID(ss.Context(), log)
+		defer func() {
+			if r := recover(); r != nil {
+				err = recovered(logger, info.FullMethod, r)
+			}
+			logCall(logger, info.FullMethod, start, err)
+			err = toStatus(err)
+		}()
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// recovered логирует панику обработчика со стеком и, как recovery в HTTP-роутере, возвращает
+// клиенту общую ошибку, не раскрывая значение паники.
+func recovered(logger *slog.Logger, method string, r any) error {
+	logger.Error("Panic in gRPC handler", "method", method, "panic", r, "stack", string(debug.Stack()))
+	return status.Error(codes.Internal, "internal error")
+}
+
+// serverStream подменяет контекст потока, чтобы обработчик видел логгер и идентификатор запроса.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// withRequestID делает то же, что middleware HTTP-роутера: берёт x-request-id из метаданных
+// или генерирует новый, возвращает его в заголовках ответа и кладёт в контекст логгер запроса.
+func withRequestID(ctx context.Context, log *slog.Logger) (context.Context, *slog.Logger) {
+	key := strings.ToLower(logging.RequestIDHeader)
+	var id string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(key); len(values) > 0 {
+			id = values[0]
+		}
+	}
+	if !logging.ValidRequestID(id) {
+		id = logging.NewRequestID()
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(key, id))
+
+	logger := log.With("request_id", id)
+	ctx = logging.WithRequestID(ctx, id)
+	return logging.WithLogger(ctx, logger), logger
+}
+
+// logCall логирует вызов с исходной ошибкой обработчика: клиент внутренних ошибок её не увидит.
+func logCall(log *slog.Logger, method string, start time.Time, err error) {
+	code := status.Code(toStatus(err))
+	attrs := []any{"method", method, "code", code.String(), "duration", time.Since(start)}
+	switch code {
+	case codes.OK:
+		log.Info("gRPC call handled", attrs...)
+	case codes.Internal, codes.Unknown, codes.Unavailable:
+		log.Error("gRPC call failed", append(attrs, "error", err)...)
+	default:
+		log.Warn("gRPC call rejected", append(attrs, "error", err)...)
+	}
+}
+
+// toStatus сопоставляет ошибки так же, как HTTP-обработчики, через apierror.Describe: отсутствующая
+// запись — NotFound, конфликт — AlreadyExists, ошибка запроса — InvalidArgument с перечнем полей,
+// остальные ошибки — Internal с общим текстом, как при панике. Исходную ошибку логирует logCall.
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	httpStatus, _, detail, fields := apierror.Describe(err)
+	var code codes.Code
+	switch {
+	case httpStatus == http.StatusNotFound:
+		code = codes.NotFound
+	case httpStatus == http.StatusConflict:
+		code = codes.AlreadyExists
+	case httpStatus < http.StatusInternalServerError:
+		code = codes.InvalidArgument
+	default:
+		return status.Error(codes.Internal, "internal error")
+	}
+	st := status.New(code, detail)
+	if len(fields) > 0 {
+		violations := make([]*errdetails.BadRequest_FieldViolation, len(fields))
+		for i, f := range fields {
+			violations[i] = &errdetails.BadRequest_FieldViolation{Field: f.Field, Description: f.Message}
+		}
+		if detailed, detailsErr := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations}); detailsErr == nil {
+			st = detailed
+		}
+	}
+	return st.Err()
+}
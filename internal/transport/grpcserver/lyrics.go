@@ -0,0 +1,96 @@
+package grpcserver
+
+import (
+	musiclibv1 "Music_Library/api/musiclib/v1"
+	"Music_Library/internal/database/postgres"
+	"Music_Library/internal/models"
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type lyricServer struct {
+	musiclibv1.UnimplementedLyricServiceServer
+}
+
+func (s *lyricServer) GetLyric(ctx context.Context, req *musiclibv1.GetLyricRequest) (*musiclibv1.Lyric, error) {
+	lyric, err := postgres.GetLyric(ctx, uint(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return toLyric(lyric), nil
+}
+
+func (s *lyricServer) ListLyrics(ctx context.Context, req *musiclibv1.ListLyricsRequest) (*musiclibv1.ListLyricsResponse, error) {
+	offset, size, err := page(req.GetOffset(), req.GetPageSize())
+	if err != nil {
+		return nil, err
+	}
+	lyrics, total, err := postgres.GetLyrics(ctx, uint(req.GetFilter().GetSongId()), offset, size)
+	if err != nil {
+		return nil, err
+	}
+	resp := &musiclibv1.ListLyricsResponse{
+		Pagination: &musiclibv1.Pagination{Total: total, Offset: req.GetOffset(), PageSize: int32(size)},
+	}
+	for i := range lyrics {
+		resp.Lyrics = append(resp.Lyrics, toLyric(&lyrics[i]))
+	}
+	return resp, nil
+}
+
+func (s *lyricServer) ListAllLyrics(req *musiclibv1.ListAllLyricsRequest, stream grpc.ServerStreamingServer[musiclibv1.Lyric]) error {
+	return postgres.EachLyric(stream.Context(), uint(req.GetFilter().GetSongId()), streamBatchSize, func(lyric *models.Lyric) error {
+		if err := stream.Context().Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+		return stream.Send(toLyric(lyric))
+	})
+}
+
+func (s *lyricServer) CreateLyric(ctx context.Context, req *musiclibv1.CreateLyricRequest) (*musiclibv1.Lyric, error) {
+	if req.GetLyric() == nil {
+		return nil, status.Error(codes.InvalidArgument, "lyric is required")
+	}
+	lyric := fromLyric(req.GetLyric())
+	if err := postgres.AddLyric(ctx, lyric); err != nil {
+		return nil, err
+	}
+	return toLyric(lyric), nil
+}
+
+func (s *lyricServer) UpdateLyric(ctx context.Context, req *musiclibv1.UpdateLyricRequest) (*musiclibv1.Lyric, error) {
+	if req.GetLyric() == nil {
+		return nil, status.Error(codes.InvalidArgument, "lyric is required")
+	}
+	lyric, err := postgres.UpdateLyric(ctx, uint(req.GetId()), fromLyric(req.GetLyric()))
+	if err != nil {
+		return nil, err
+	}
+	return toLyric(lyric), nil
+}
+
+func (s *lyricServer) DeleteLyric(ctx context.Context, req *musiclibv1.DeleteLyricRequest) (*musiclibv1.DeleteLyricResponse, error) {
+	if err := postgres.DeleteLyric(ctx, uint(req.GetId())); err != nil {
+		return nil, err
+	}
+	return &musiclibv1.DeleteLyricResponse{Id: req.GetId()}, nil
+}
+
+func toLyric(lyric *models.Lyric) *musiclibv1.Lyric {
+	return &musiclibv1.Lyric{
+		Id:          uint64(lyric.ID),
+		SongId:      uint64(lyric.SongID),
+		VerseNumber: int32(lyric.VerseNumber),
+		Text:        lyric.Text,
+	}
+}
+
+func fromLyric(pb *musiclibv1.Lyric) *models.Lyric {
+	return &models.Lyric{
+		SongID:      uint(pb.GetSongId()),
+		VerseNumber: int(pb.GetVerseNumber()),
+		Text:        pb.GetText(),
+	}
+}
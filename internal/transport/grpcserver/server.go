@@ -0,0 +1,44 @@
+package grpcserver
+
+import (
+	musiclibv1 "Music_Library/api/musiclib/v1"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log/slog"
+)
+
+const (
+	defaultPageSize = 10
+	maxPageSize     = 100
+	streamBatchSize = 100
+)
+
+// New создаёт gRPC-сервер с сервисами песен и куплетов.
+func New(log *slog.Logger) *grpc.Server {
+	server := grpc.NewServer(
+		// Span'ы вызовов и traceparent из метаданных, как у HTTP-запросов.
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(unaryInterceptor(log)),
+		grpc.ChainStreamInterceptor(streamInterceptor(log)),
+	)
+	musiclibv1.RegisterSongServiceServer(server, &songServer{})
+	musiclibv1.RegisterLyricServiceServer(server, &lyricServer{})
+	return server
+}
+
+// page проверяет пагинацию запроса, как REST и GraphQL: offset не может быть отрицательным,
+// а размер страницы — больше maxPageSize. Нулевой размер означает defaultPageSize.
+func page(offset, size int32) (int, int, error) {
+	if offset < 0 {
+		return 0, 0, status.Error(codes.InvalidArgument, "offset must not be negative")
+	}
+	if size == 0 {
+		return int(offset), defaultPageSize, nil
+	}
+	if size < 0 || size > maxPageSize {
+		return 0, 0, status.Errorf(codes.InvalidArgument, "page_size must be between 1 and %d", maxPageSize)
+	}
+	return int(offset), int(size), nil
+}
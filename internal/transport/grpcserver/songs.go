@@ -0,0 +1,133 @@
+package grpcserver
+
+import (
+	musiclibv1 "Music_Library/api/musiclib/v1"
+	"Music_Library/internal/database/postgres"
+	"Music_Library/internal/models"
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type songServer struct {
+	musiclibv1.UnimplementedSongServiceServer
+}
+
+func (s *songServer) GetSong(ctx context.Context, req *musiclibv1.GetSongRequest) (*musiclibv1.Song, error) {
+	song, err := postgres.GetSong(ctx, uint(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return toSong(song), nil
+}
+
+func (s *songServer) ListSongs(ctx context.Context, req *musiclibv1.ListSongsRequest) (*musiclibv1.ListSongsResponse, error) {
+	f := req.GetFilter()
+	offset, size, err := page(req.GetOffset(), req.GetPageSize())
+	if err != nil {
+		return nil, err
+	}
+	order, err := postgres.ParseSongSort(req.GetSort())
+	if err != nil {
+		return nil, err
+	}
+	songs, total, err := postgres.GetAllSongs(ctx, toFilter(f), order, postgres.SongFields{Lyrics: true}, offset, size)
+	if err != nil {
+		return nil, err
+	}
+	resp := &musiclibv1.ListSongsResponse{
+		Pagination: &musiclibv1.Pagination{Total: total, Offset: req.GetOffset(), PageSize: int32(size)},
+	}
+	for i := range songs {
+		resp.Songs = append(resp.Songs, toSong(&songs[i]))
+	}
+	return resp, nil
+}
+
+func (s *songServer) ListAllSongs(req *musiclibv1.ListAllSongsRequest, stream grpc.ServerStreamingServer[musiclibv1.Song]) error {
+	f := req.GetFilter()
+	return postgres.EachSong(stream.Context(), toFilter(f), streamBatchSize, func(song *models.Song) error {
+		if err := stream.Context().Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+		return stream.Send(toSong(song))
+	})
+}
+
+func (s *songServer) CreateSong(ctx context.Context, req *musiclibv1.CreateSongRequest) (*musiclibv1.Song, error) {
+	if req.GetSong() == nil {
+		return nil, status.Error(codes.InvalidArgument, "song is required")
+	}
+	song := fromSong(req.GetSong())
+	if err := postgres.AddSong(ctx, song); err != nil {
+		return nil, err
+	}
+	return toSong(song), nil
+}
+
+func (s *songServer) UpdateSong(ctx context.Context, req *musiclibv1.UpdateSongRequest) (*musiclibv1.Song, error) {
+	if req.GetSong() == nil {
+		return nil, status.Error(codes.InvalidArgument, "song is required")
+	}
+	song, err := postgres.UpdateSong(ctx, uint(req.GetId()), fromSong(req.GetSong()))
+	if err != nil {
+		return nil, err
+	}
+	return toSong(song), nil
+}
+
+func (s *songServer) DeleteSong(ctx context.Context, req *musiclibv1.DeleteSongRequest) (*musiclibv1.DeleteSongResponse, error) {
+	if err := postgres.DeleteSong(ctx, uint(req.GetId())); err != nil {
+		return nil, err
+	}
+	return &musiclibv1.DeleteSongResponse{Id: req.GetId()}, nil
+}
+
+func toSong(song *models.Song) *musiclibv1.Song {
+	pb := &musiclibv1.Song{
+		Id:          uint64(song.ID),
+		Group:       song.Group,
+		Title:       song.Title,
+		ReleaseDate: song.ReleaseDate.String(),
+		Link:        song.Link,
+	}
+	for i := range song.Lyrics {
+		pb.Lyrics = append(pb.Lyrics, toLyric(&song.Lyrics[i]))
+	}
+	return pb
+}
+
+func fromSong(pb *musiclibv1.Song) *models.Song {
+	song := &models.Song{
+		Group:       pb.GetGroup(),
+		Title:       pb.GetTitle(),
+		ReleaseDate: models.NewReleaseDate(pb.GetReleaseDate()),
+		Link:        pb.GetLink(),
+	}
+	for _, l := range pb.GetLyrics() {
+		song.Lyrics = append(song.Lyrics, *fromLyric(l))
+	}
+	return song
+}
+
+func toFilter(f *musiclibv1.SongFilter) postgres.SongFilter {
+	ids := make([]uint, len(f.GetIds()))
+	for i, id := range f.GetIds() {
+		ids[i] = uint(id)
+	}
+	filter := postgres.SongFilter{
+		IDs:            ids,
+		Group:          f.GetGroup(),
+		Title:          f.GetTitle(),
+		Link:           f.GetLink(),
+		ReleaseDate:    models.NewReleaseDate(f.GetReleaseDate()),
+		ReleasedAfter:  models.NewReleaseDate(f.GetReleasedAfter()),
+		ReleasedBefore: models.NewReleaseDate(f.GetReleasedBefore()),
+	}
+	// В proto3 у year нет признака наличия, поэтому ноль означает, что год не задан.
+	if year := int(f.GetYear()); year != 0 {
+		filter.Year = &year
+	}
+	return filter
+}
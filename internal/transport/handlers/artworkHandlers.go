@@ -0,0 +1,177 @@
+package handlers
+
+import (
+	"Music_Library/internal/artwork"
+	"Music_Library/internal/database/postgres"
+	"Music_Library/internal/models"
+	"Music_Library/internal/storage"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// UploadArtwork godoc
+//
+//	@Summary		Upload cover art for a song
+//	@Description	Validates a JPEG or PNG image (at most 10 MB, between 300x300 and 6000x6000 pixels), generates small (100px), medium (300px) and large (600px) thumbnails and replaces the song's cover art.
+//	@Tags			artwork
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Param			id		path		int						true	"ID of the song"
+//	@Param			image	formData	file					true	"JPEG or PNG image"
+//	@Success		200		{array}		models.Artwork			"Stored image sizes"
+//	@Failure		400		{object}	models.ErrorResponse	"Invalid song ID or image"
+//	@Failure		404		{object}	models.ErrorResponse	"Song not found"
+//	@Failure		413		{object}	models.ErrorResponse	"Image is too large"
+//	@Router			/songs/{id}/artwork [put]
+func UploadArtwork(c *gin.Context, logger *slog.Logger) {
+	disableTimeouts(c, logger)
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		logger.Warn("Invalid song ID", "id", c.Param("id"), "error", err)
+		models.NewErrorResponse(c, 400, err.Error())
+		return
+	}
+	limitBody(c, artwork.MaxFileSize)
+	header, err := c.FormFile("image")
+	if tooLarge(err) || err == nil && header.Size > artwork.MaxFileSize {
+		logger.Warn("Image is too large", "content_length", c.Request.ContentLength)
+		models.NewErrorResponse(c, 413, fmt.Sprintf("image must not exceed %d bytes", artwork.MaxFileSize))
+		return
+	}
+	if err != nil {
+		logger.Warn("Missing image file", "error", err)
+		models.NewErrorResponse(c, 400, err.Error())
+		return
+	}
+	file, err := header.Open()
+	if err != nil {
+		abortWithError(c, logger, "Failed to open uploaded image", err)
+		return
+	}
+	data, err := io.ReadAll(file)
+	file.Close()
+	if err != nil {
+		abortWithError(c, logger, "Failed to read uploaded image", err)
+		return
+	}
+
+	renditions, err := artwork.Process(data)
+	if err != nil {
+		logger.Warn("Invalid cover art", "filename", header.Filename, "error", err)
+		models.NewErrorResponse(c, 400, err.Error())
+		return
+	}
+
+	images := make([]models.Artwork, 0, len(renditions))
+	keys := make([]string, 0, len(renditions))
+	for _, r := range renditions {
+		sum := sha256.Sum256(r.Data)
+		key := hex.EncodeToString(sum[:]) + r.Extension
+		keys = append(keys, key)
+		images = append(images, models.Artwork{
+			Size:        r.Size,
+			Key:         key,
+			Width:       r.Width,
+			Height:      r.Height,
+			ContentType: r.ContentType,
+			URL:         "/artwork/" + key,
+		})
+	}
+
+	// Изображения сохраняются и прикрепляются под блокировкой их ключей, чтобы одновременное
+	// удаление последней ссылки на такое же изображение не стёрло только что записанный файл.
+	var previous []string
+	err = postgres.WithBlobs(c.Request.Context(), keys, func(ctx context.Context) error {
+		for i, r := range renditions {
+			if err := storage.Blobs.Put(keys[i], bytes.NewReader(r.Data)); err != nil {
+				return fmt.Errorf("store %s cover art: %w", r.Size, err)
+			}
+		}
+		var err error
+		previous, err = postgres.ReplaceArtwork(ctx, uint(id), images)
+		return err
+	})
+	if err != nil {
+		// Освобождаются только изображения, на которые никто не ссылается.
+		postgres.ReleaseArtwork(c.Request.Context(), keys)
+		abortWithError(c, logger, "Error saving cover art", err, "id", id)
+		return
+	}
+	postgres.ReleaseArtwork(c.Request.Context(), previous)
+	logger.Info("Successfully uploaded cover art", "song_id", id)
+	c.JSON(http.StatusOK, gin.H{"artwork": images})
+}
+
+// DeleteArtwork godoc
+//
+//	@Summary		Delete the cover art of a song
+//	@Description	Removes all sizes of the song's cover art
+//	@Tags			artwork
+//	@Produce		json
+//	@Param			id	path		int						true	"ID of the song"
+//	@Success		200	{object}	models.Response			"ID of the song"
+//	@Failure		400	{object}	models.ErrorResponse	"Invalid song ID"
+//	@Failure		404	{object}	models.ErrorResponse	"Song has no cover art"
+//	@Router			/songs/{id}/artwork [delete]
+func DeleteArtwork(c *gin.Context, logger *slog.Logger) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		logger.Warn("Invalid song ID", "id", c.Param("id"), "error", err)
+		models.NewErrorResponse(c, 400, err.Error())
+		return
+	}
+	keys, err := postgres.DeleteArtwork(c.Request.Context(), uint(id))
+	if err != nil {
+		abortWithError(c, logger, "Error deleting cover art", err, "id", id)
+		return
+	}
+	postgres.ReleaseArtwork(c.Request.Context(), keys)
+	logger.Info("Successfully deleted cover art", "song_id", id)
+	models.NewResponse(c, id, "successfully deleted")
+}
+
+// GetArtwork godoc
+//
+//	@Summary		Get a cover art image
+//	@Description	Serves a cover art image. Image URLs change whenever the image changes, so responses can be cached forever.
+//	@Tags			artwork
+//	@Produce		image/jpeg
+//	@Produce		image/png
+//	@Param			key	path		string					true	"Image key from the song's artwork URL"
+//	@Success		200	{file}		file					"Image"
+//	@Failure		404	{object}	models.ErrorResponse	"Image not found"
+//	@Router			/artwork/{key} [get]
+func GetArtwork(c *gin.Context, logger *slog.Logger) {
+	key := c.Param("key")
+	image, err := postgres.GetArtworkByKey(c.Request.Context(), key)
+	if err != nil {
+		abortWithError(c, logger, "Error fetching cover art", err, "key", key)
+		return
+	}
+	blob, err := storage.Blobs.Open(image.Key)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			logger.Warn("Cover art blob is missing", "key", key)
+			models.NewErrorResponse(c, 404, err.Error())
+		} else {
+			abortWithError(c, logger, "Failed to open cover art blob", err, "key", key)
+		}
+		return
+	}
+	defer blob.Close()
+
+	c.Header("Content-Type", image.ContentType)
+	c.Header("ETag", `"`+image.Key+`"`)
+	c.Header("Cache-Control", "public, max-age=31536000, immutable")
+	http.ServeContent(c.Writer, c.Request, image.Key, time.Time{}, blob)
+}
@@ -0,0 +1,211 @@
+package handlers
+
+import (
+	"Music_Library/internal/database/postgres"
+	"Music_Library/internal/models"
+	"Music_Library/internal/storage"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"io"
+	"log/slog"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const maxAudioSize = 200 << 20
+
+var audioExtensions = map[string]string{
+	".mp3":  "audio/mpeg",
+	".flac": "audio/flac",
+	".ogg":  "audio/ogg",
+	".oga":  "audio/ogg",
+	".wav":  "audio/wav",
+	".m4a":  "audio/mp4",
+}
+
+// UploadAudio godoc
+//
+//	@Summary		Attach an audio file to a song
+//	@Description	Stores the uploaded audio file and attaches it to the song, replacing the previous one. Files are stored by their SHA-256 checksum, so identical uploads are kept only once.
+//	@Tags			audio
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Param			id		path		int						true	"ID of the song"
+//	@Param			file	formData	file					true	"Audio file"
+//	@Success		200		{object}	models.AudioFile		"Attached audio file"
+//	@Failure		400		{object}	models.ErrorResponse	"Invalid song ID or not an audio file"
+//	@Failure		404		{object}	models.ErrorResponse	"Song not found"
+//	@Failure		413		{object}	models.ErrorResponse	"File is too large"
+//	@Router			/songs/{id}/audio [put]
+func UploadAudio(c *gin.Context, logger *slog.Logger) {
+	disableTimeouts(c, logger)
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		logger.Warn("Invalid song ID", "id", c.Param("id"), "error", err)
+		models.NewErrorResponse(c, 400, err.Error())
+		return
+	}
+	limitBody(c, maxAudioSize)
+	header, err := c.FormFile("file")
+	if tooLarge(err) || err == nil && header.Size > maxAudioSize {
+		logger.Warn("Audio file is too large", "content_length", c.Request.ContentLength)
+		models.NewErrorResponse(c, 413, fmt.Sprintf("audio file must not exceed %d bytes", maxAudioSize))
+		return
+	}
+	if err != nil {
+		logger.Warn("Missing audio file", "error", err)
+		models.NewErrorResponse(c, 400, err.Error())
+		return
+	}
+	file, err := header.Open()
+	if err != nil {
+		abortWithError(c, logger, "Failed to open uploaded file", err)
+		return
+	}
+	defer file.Close()
+
+	head := make([]byte, 512)
+	n, _ := io.ReadFull(file, head)
+	contentType := detectAudioType(head[:n], header.Filename)
+	if contentType == "" {
+		logger.Warn("Uploaded file is not audio", "filename", header.Filename)
+		models.NewErrorResponse(c, 400, "file is not a supported audio format")
+		return
+	}
+
+	hash := sha256.New()
+	if _, err = file.Seek(0, io.SeekStart); err == nil {
+		_, err = io.Copy(hash, file)
+	}
+	if err != nil {
+		abortWithError(c, logger, "Failed to checksum audio file", err)
+		return
+	}
+	checksum := hex.EncodeToString(hash.Sum(nil))
+
+	audio := models.AudioFile{
+		SongID:      uint(id),
+		Checksum:    checksum,
+		Size:        header.Size,
+		ContentType: contentType,
+		Filename:    filepath.Base(header.Filename),
+	}
+	// Файл сохраняется и прикрепляется под блокировкой его суммы, иначе одновременное удаление
+	// последней ссылки на такой же файл могло бы стереть его уже после проверки Exists.
+	var exists bool
+	var previous string
+	err = postgres.WithBlobs(c.Request.Context(), []string{checksum}, func(ctx context.Context) error {
+		if exists, err = storage.Blobs.Exists(checksum); err != nil {
+			return fmt.Errorf("check blob storage: %w", err)
+		}
+		if !exists {
+			if _, err = file.Seek(0, io.SeekStart); err == nil {
+				err = storage.Blobs.Put(checksum, file)
+			}
+			if err != nil {
+				return fmt.Errorf("store audio file: %w", err)
+			}
+		}
+		previous, err = postgres.SaveAudioFile(ctx, &audio)
+		return err
+	})
+	if err != nil {
+		if !exists {
+			postgres.ReleaseAudio(c.Request.Context(), checksum)
+		}
+		abortWithError(c, logger, "Error saving audio file", err, "id", id)
+		return
+	}
+	if previous != checksum {
+		postgres.ReleaseAudio(c.Request.Context(), previous)
+	}
+	logger.Info("Successfully attached audio file", "song_id", id, "checksum", checksum, "deduplicated", exists)
+	c.JSON(http.StatusOK, gin.H{"audio": audio})
+}
+
+// StreamAudio godoc
+//
+//	@Summary		Stream the audio file of a song
+//	@Description	Streams the attached audio file. Supports Range requests for seeking and conditional requests with ETag.
+//	@Tags			audio
+//	@Produce		audio/mpeg
+//	@Produce		audio/flac
+//	@Param			id		path		int						true	"ID of the song"
+//	@Param			Range	header		string					false	"Byte range, e.g. bytes=0-1023"
+//	@Success		200		{file}		file					"Whole audio file"
+//	@Success		206		{file}		file					"Requested byte range"
+//	@Success		304		{string}	string					"Not modified"
+//	@Failure		400		{object}	models.ErrorResponse	"Invalid song ID"
+//	@Failure		404		{object}	models.ErrorResponse	"Song has no audio file"
+//	@Failure		416		{string}	string					"Range not satisfiable"
+//	@Router			/songs/{id}/audio [get]
+func StreamAudio(c *gin.Context, logger *slog.Logger) {
+	disableTimeouts(c, logger)
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		logger.Warn("Invalid song ID", "id", c.Param("id"), "error", err)
+		models.NewErrorResponse(c, 400, err.Error())
+		return
+	}
+	audio, err := postgres.GetAudioFile(c.Request.Context(), uint(id))
+	if err != nil {
+		abortWithError(c, logger, "Error fetching audio file", err, "id", id)
+		return
+	}
+	blob, err := storage.Blobs.Open(audio.Checksum)
+	if err != nil {
+		abortWithError(c, logger, "Failed to open audio blob", err, "id", id, "checksum", audio.Checksum)
+		return
+	}
+	defer blob.Close()
+
+	c.Header("Content-Type", audio.ContentType)
+	c.Header("ETag", `"`+audio.Checksum+`"`)
+	c.Header("Content-Disposition", fmt.Sprintf("inline; filename=%q", audio.Filename))
+	http.ServeContent(c.Writer, c.Request, audio.Filename, time.Time{}, blob)
+}
+
+// DeleteAudio godoc
+//
+//	@Summary		Detach the audio file from a song
+//	@Description	Removes the audio file from the song. The stored file is deleted when no other song uses it.
+//	@Tags			audio
+//	@Produce		json
+//	@Param			id	path		int						true	"ID of the song"
+//	@Success		200	{object}	models.Response			"ID of the song"
+//	@Failure		400	{object}	models.ErrorResponse	"Invalid song ID"
+//	@Failure		404	{object}	models.ErrorResponse	"Song has no audio file"
+//	@Router			/songs/{id}/audio [delete]
+func DeleteAudio(c *gin.Context, logger *slog.Logger) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		logger.Warn("Invalid song ID", "id", c.Param("id"), "error", err)
+		models.NewErrorResponse(c, 400, err.Error())
+		return
+	}
+	checksum, err := postgres.DeleteAudioFile(c.Request.Context(), uint(id))
+	if err != nil {
+		abortWithError(c, logger, "Error deleting audio file", err, "id", id)
+		return
+	}
+	postgres.ReleaseAudio(c.Request.Context(), checksum)
+	logger.Info("Successfully deleted audio file", "song_id", id)
+	models.NewResponse(c, id, "successfully deleted")
+}
+
+// detectAudioType определяет MIME-тип по содержимому файла, а если это не удаётся — по расширению.
+func detectAudioType(head []byte, filename string) string {
+	if len(head) >= 4 && string(head[:4]) == "fLaC" {
+		return "audio/flac"
+	}
+	if contentType := http.DetectContentType(head); strings.HasPrefix(contentType, "audio/") {
+		return contentType
+	}
+	return audioExtensions[strings.ToLower(filepath.Ext(filename))]
+}
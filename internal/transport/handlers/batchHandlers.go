@@ -0,0 +1,227 @@
+package handlers
+
+import (
+	"Music_Library/internal/database/postgres"
+	"Music_Library/internal/models"
+	"Music_Library/internal/transport/apierror"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"log/slog"
+	"net/http"
+)
+
+// Batch godoc
+//
+//	@Summary		Run a batch of operations
+//	@Description	Run up to 500 get, create, update, patch and delete operations on songs and lyrics in order. With atomic set all operations run in one transaction: the first failure rolls back the others, committed is false and the other operations get status 424. Otherwise every operation runs on its own. Either way the response is 200 with a status for each operation.
+//	@Tags			batch
+//	@Accept			json
+//	@Produce		json
+//	@Param			batch	body		models.BatchRequest		true	"Operations to run"
+//	@Success		200		{object}	models.BatchResponse	"Results in the order of the operations"
+//	@Failure		400		{object}	models.ErrorResponse	"Invalid batch"
+//	@Router			/batch [post]
+func Batch(c *gin.Context, logger *slog.Logger) {
+	var req models.BatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Invalid batch body", "error", err)
+		models.NewErrorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	if fields := validateBatch(&req); len(fields) > 0 {
+		logger.Warn("Invalid batch", "errors", fields)
+		models.NewProblem(c, http.StatusBadRequest, models.CodeValidationFailed, "invalid batch", fields)
+		return
+	}
+
+	resp := models.BatchResponse{Committed: true, Results: make([]models.BatchResult, len(req.Operations))}
+	if !req.Atomic {
+		failed := 0
+		for i, op := range req.Operations {
+			var err error
+			if resp.Results[i], err = runOperation(c.Request.Context(), logger, i, op); err != nil {
+				failed++
+			}
+		}
+		logger.Info("Batch finished", "operations", len(req.Operations), "failed", failed)
+		c.JSON(http.StatusOK, resp)
+		return
+	}
+
+	failed := -1
+	err := postgres.InTransaction(c.Request.Context(), func(ctx context.Context) error {
+		for i, op := range req.Operations {
+			var err error
+			if resp.Results[i], err = runOperation(ctx, logger, i, op); err != nil {
+				failed = i
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil && failed < 0 {
+		abortWithError(c, logger, "Failed to commit batch", err)
+		return
+	}
+	if err != nil {
+		resp.Committed = false
+		for i := range resp.Results {
+			if i == failed {
+				continue
+			}
+			detail := fmt.Sprintf("rolled back because operation %d failed", failed)
+			if i > failed {
+				detail = fmt.Sprintf("not run because operation %d failed", failed)
+			}
+			resp.Results[i] = models.BatchResult{Status: http.StatusFailedDependency,
+				Error: &models.BatchError{Code: models.StatusCode(http.StatusFailedDependency), Detail: detail}}
+		}
+		logger.Warn("Atomic batch rolled back", "operations", len(req.Operations), "failed", failed)
+		c.JSON(http.StatusOK, resp)
+		return
+	}
+	logger.Info("Atomic batch committed", "operations", len(req.Operations))
+	c.JSON(http.StatusOK, resp)
+}
+
+// validateBatch проверяет состав пакета до выполнения операций.
+func validateBatch(req *models.BatchRequest) []models.FieldError {
+	var fields []models.FieldError
+	switch {
+	case len(req.Operations) == 0:
+		fields = append(fields, models.FieldError{Field: "operations", Code: models.CodeRequired, Message: "is required"})
+	case len(req.Operations) > models.MaxBatchOperations:
+		fields = append(fields, models.FieldError{Field: "operations", Code: models.CodeInvalid,
+			Message: fmt.Sprintf("must contain at most %d operations", models.MaxBatchOperations)})
+	}
+	for i, op := range req.Operations {
+		field := func(name string) string { return fmt.Sprintf("operations[%d].%s", i, name) }
+		switch op.Op {
+		case "get", "create", "update", "patch", "delete":
+		default:
+			fields = append(fields, models.FieldError{Field: field("op"), Code: models.CodeInvalid,
+				Message: "must be one of get, create, update, patch, delete"})
+		}
+		if op.Resource != "song" && op.Resource != "lyric" {
+			fields = append(fields, models.FieldError{Field: field("resource"), Code: models.CodeInvalid, Message: "must be song or lyric"})
+		}
+		if op.Op != "create" && op.ID == 0 {
+			fields = append(fields, models.FieldError{Field: field("id"), Code: models.CodeRequired, Message: "is required"})
+		}
+		if (op.Op == "create" || op.Op == "update" || op.Op == "patch") && len(op.Data) == 0 {
+			fields = append(fields, models.FieldError{Field: field("data"), Code: models.CodeRequired, Message: "is required"})
+		}
+	}
+	return fields
+}
+
+// runOperation выполняет одну операцию пакета и возвращает её результат вместе с ошибкой.
+func runOperation(ctx context.Context, logger *slog.Logger, index int, op models.BatchOperation) (models.BatchResult, error) {
+	var (
+		status int
+		data   any
+		err    error
+	)
+	if op.Resource == "song" {
+		status, data, err = runSongOperation(ctx, op)
+	} else {
+		status, data, err = runLyricOperation(ctx, op)
+	}
+	if err == nil {
+		return models.BatchResult{Status: status, Data: data}, nil
+	}
+
+	status, code, detail, fields := apierror.Describe(err)
+	args := []any{"index", index, "op", op.Op, "resource", op.Resource, "id", op.ID, "error", err}
+	if status >= http.StatusInternalServerError {
+		logger.Error("Batch operation failed", args...)
+	} else {
+		logger.Warn("Batch operation failed", args...)
+	}
+	return models.BatchResult{Status: status, Error: &models.BatchError{Code: code, Detail: detail, Errors: fields}}, err
+}
+
+func runSongOperation(ctx context.Context, op models.BatchOperation) (int, any, error) {
+	switch op.Op {
+	case "get":
+		song, err := postgres.GetSong(ctx, op.ID)
+		return http.StatusOK, song, err
+	case "create":
+		var song models.Song
+		if err := decodeData(op.Data, &song); err != nil {
+			return 0, nil, err
+		}
+		err := postgres.AddSong(ctx, &song)
+		return http.StatusCreated, &song, err
+	case "update":
+		var song models.Song
+		if err := decodeData(op.Data, &song); err != nil {
+			return 0, nil, err
+		}
+		updated, err := postgres.ReplaceSong(ctx, op.ID, &song)
+		return http.StatusOK, updated, err
+	case "patch":
+		p, err := dataPatch(op.Data)
+		if err != nil {
+			return 0, nil, err
+		}
+		song, err := postgres.PatchSong(ctx, op.ID, func(song *models.Song) error {
+			return applyPatch(p, song)
+		})
+		return http.StatusOK, song, err
+	}
+	err := postgres.DeleteSong(ctx, op.ID)
+	return http.StatusOK, models.Response{ID: int(op.ID), Message: "successfully deleted"}, err
+}
+
+func runLyricOperation(ctx context.Context, op models.BatchOperation) (int, any, error) {
+	switch op.Op {
+	case "get":
+		lyric, err := postgres.GetLyric(ctx, op.ID)
+		return http.StatusOK, lyric, err
+	case "create":
+		var lyric models.Lyric
+		if err := decodeData(op.Data, &lyric); err != nil {
+			return 0, nil, err
+		}
+		err := postgres.AddLyric(ctx, &lyric)
+		return http.StatusCreated, &lyric, err
+	case "update":
+		var lyric models.Lyric
+		if err := decodeData(op.Data, &lyric); err != nil {
+			return 0, nil, err
+		}
+		updated, err := postgres.ReplaceLyric(ctx, op.ID, &lyric)
+		return http.StatusOK, updated, err
+	case "patch":
+		p, err := dataPatch(op.Data)
+		if err != nil {
+			return 0, nil, err
+		}
+		lyric, err := postgres.PatchLyric(ctx, op.ID, func(lyric *models.Lyric) error {
+			return applyPatch(p, lyric)
+		})
+		return http.StatusOK, lyric, err
+	}
+	err := postgres.DeleteLyric(ctx, op.ID)
+	return http.StatusOK, models.Response{ID: int(op.ID), Message: "successfully deleted"}, err
+}
+
+// decodeData разбирает данные операции create или update.
+func decodeData(data json.RawMessage, v any) error {
+	if err := json.Unmarshal(data, v); err != nil {
+		return &statusError{status: http.StatusBadRequest, err: fmt.Errorf("invalid data: %w", err)}
+	}
+	return nil
+}
+
+// dataPatch разбирает данные операции patch: объект — JSON Merge Patch, массив — операции JSON Patch.
+func dataPatch(data json.RawMessage) (*patch, error) {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		return newPatch(jsonPatchContentType, data)
+	}
+	return newPatch(mergePatchContentType, data)
+}
@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"Music_Library/internal/models"
+	"Music_Library/internal/transport/apierror"
+	"github.com/gin-gonic/gin"
+	"log/slog"
+	"net/http"
+)
+
+// statusError — ошибка в запросе, для которой известен статус ответа, например неприменимый патч.
+type statusError struct {
+	status int
+	err    error
+}
+
+func (e *statusError) Error() string {
+	return e.err.Error()
+}
+
+func (e *statusError) Unwrap() error {
+	return e.err
+}
+
+func (e *statusError) Status() int {
+	return e.status
+}
+
+// abortWithError логирует ошибку и отвечает статусом из apierror.Describe. Неизвестные ошибки
+// логируются как Error, а клиенту вместо их текста уходит только идентификатор запроса.
+func abortWithError(c *gin.Context, logger *slog.Logger, msg string, err error, args ...any) {
+	status, code, detail, fields := apierror.Describe(err)
+	args = append(args, "error", err)
+	if status >= http.StatusInternalServerError {
+		logger.Error(msg, args...)
+	} else {
+		logger.Warn(msg, args...)
+	}
+	models.NewProblem(c, status, code, detail, fields)
+}
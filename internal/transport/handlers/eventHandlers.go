@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"Music_Library/internal/events"
+	"Music_Library/internal/models"
+	"encoding/json"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const heartbeatInterval = 15 * time.Second
+
+// StreamEvents godoc
+//
+//	@Summary		Live change feed
+//	@Description	Streams song and lyric create, update and delete events as Server-Sent Events. Each event has an id; after a disconnect the stream resumes from the Last-Event-ID header (or last_event_id parameter). If the missed events are no longer kept, a "reset" event is sent first and the client should reload its data.
+//	@Tags			events
+//	@Produce		text/event-stream
+//	@Param			song_id			query		string					false	"Only events of these songs, comma-separated IDs"
+//	@Param			group			query		string					false	"Only events of songs by this group"
+//	@Param			Last-Event-ID	header		int						false	"ID of the last received event"
+//	@Param			last_event_id	query		int						false	"ID of the last received event, for clients that cannot set headers"
+//	@Success		200				{string}	string					"Event stream"
+//	@Failure		400				{object}	models.ErrorResponse	"Invalid filter"
+//	@Router			/events [get]
+func StreamEvents(c *gin.Context, logger *slog.Logger) {
+	disableTimeouts(c, logger)
+	songIDs := make(map[uint]bool)
+	if raw := c.Query("song_id"); raw != "" {
+		for _, part := range strings.Split(raw, ",") {
+			id, err := strconv.Atoi(strings.TrimSpace(part))
+			if err != nil {
+				logger.Warn("Invalid song ID filter", "song_id", raw, "error", err)
+				models.NewErrorResponse(c, 400, err.Error())
+				return
+			}
+			songIDs[uint(id)] = true
+		}
+	}
+	group := c.Query("group")
+
+	lastEventID := c.GetHeader("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = c.Query("last_event_id")
+	}
+	var lastID uint64
+	if lastEventID != "" {
+		var err error
+		if lastID, err = strconv.ParseUint(lastEventID, 10, 64); err != nil {
+			logger.Warn("Invalid Last-Event-ID", "last_event_id", lastEventID, "error", err)
+			models.NewErrorResponse(c, 400, err.Error())
+			return
+		}
+	}
+
+	match := func(e events.Event) bool {
+		if len(songIDs) > 0 && !songIDs[e.SongID] {
+			return false
+		}
+		return group == "" || e.Group == group
+	}
+
+	backlog, complete, live, cancel := events.Live.Subscribe(lastID)
+	defer cancel()
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	logger.Info("Event stream opened", "last_event_id", lastID, "backlog", len(backlog), "complete", complete)
+	if !complete {
+		fmt.Fprint(c.Writer, "event: reset\ndata: {}\n\n")
+	}
+	for _, e := range backlog {
+		if match(e) {
+			writeEvent(c.Writer, e)
+		}
+	}
+	c.Writer.Flush()
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			logger.Info("Event stream closed by client")
+			return
+		case e, ok := <-live:
+			if !ok {
+				logger.Info("Event stream closed by server")
+				return
+			}
+			if match(e) {
+				writeEvent(c.Writer, e)
+				c.Writer.Flush()
+			}
+		case <-heartbeat.C:
+			fmt.Fprint(c.Writer, ": heartbeat\n\n")
+			c.Writer.Flush()
+		}
+	}
+}
+
+func writeEvent(w io.Writer, e events.Event) {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return
+	}
+	fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", e.ID, e.Type, data)
+}
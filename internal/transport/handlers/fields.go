@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"Music_Library/internal/database/postgres"
+	"Music_Library/internal/models"
+	"encoding/json"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"strconv"
+	"strings"
+)
+
+// songFields разбирает параметры fields и include. Без include загружаются связи defaultInclude.
+func songFields(c *gin.Context, defaultInclude string) (postgres.SongFields, error) {
+	include, ok := c.GetQuery("include")
+	if !ok {
+		include = defaultInclude
+	}
+	return postgres.ParseSongFields(c.Query("fields"), include)
+}
+
+// projectSong оставляет в JSON песни ID, поля из fields (все, если список пуст) и загруженные связи.
+func projectSong(song *models.Song, fields postgres.SongFields) (map[string]json.RawMessage, error) {
+	data, err := json.Marshal(song)
+	if err != nil {
+		return nil, err
+	}
+	var full map[string]json.RawMessage
+	if err = json.Unmarshal(data, &full); err != nil {
+		return nil, err
+	}
+
+	if !fields.Lyrics {
+		delete(full, "lyrics")
+	}
+	if !fields.Artwork {
+		delete(full, "artwork")
+	}
+	if len(fields.Fields) == 0 {
+		return full, nil
+	}
+	projected := map[string]json.RawMessage{"ID": full["ID"]}
+	for _, keys := range [][]string{fields.Fields, {"lyrics", "artwork"}} {
+		for _, key := range keys {
+			if value, ok := full[key]; ok {
+				projected[key] = value
+			}
+		}
+	}
+	return projected, nil
+}
+
+// projectSongs применяет projectSong к каждой песне страницы.
+func projectSongs(songs []models.Song, fields postgres.SongFields) ([]map[string]json.RawMessage, error) {
+	projected := make([]map[string]json.RawMessage, len(songs))
+	for i := range songs {
+		var err error
+		if projected[i], err = projectSong(&songs[i], fields); err != nil {
+			return nil, err
+		}
+	}
+	return projected, nil
+}
+
+// parseIDs разбирает список ID через запятую.
+func parseIDs(s string) ([]uint, error) {
+	var ids []uint
+	for _, item := range strings.Split(s, ",") {
+		id, err := strconv.ParseUint(strings.TrimSpace(item), 10, 0)
+		if err != nil || id == 0 {
+			return nil, fmt.Errorf("invalid id %q", item)
+		}
+		ids = append(ids, uint(id))
+	}
+	return ids, nil
+}
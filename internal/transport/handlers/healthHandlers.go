@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"Music_Library/internal/database/postgres"
+	"Music_Library/internal/models"
+	"Music_Library/internal/storage"
+	"context"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const readinessTimeout = 2 * time.Second
+
+// Healthz godoc
+//
+//	@Summary		Liveness probe
+//	@Description	Reports that the process is running. Dependencies are not checked.
+//	@Tags			health
+//	@Produce		json
+//	@Success		200	{object}	models.HealthResponse	"Process is alive"
+//	@Router			/healthz [get]
+func Healthz(c *gin.Context) {
+	c.JSON(http.StatusOK, models.HealthResponse{Status: "ok"})
+}
+
+// Readyz godoc
+//
+//	@Summary		Readiness probe
+//	@Description	Checks the database connection, the schema migration version and blob storage, and reports the status of each dependency.
+//	@Tags			health
+//	@Produce		json
+//	@Success		200	{object}	models.ReadinessResponse	"All dependencies are up"
+//	@Failure		503	{object}	models.ReadinessResponse	"Some dependency is down"
+//	@Router			/readyz [get]
+func Readyz(c *gin.Context, logger *slog.Logger) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), readinessTimeout)
+	defer cancel()
+
+	checks := map[string]func(context.Context) (map[string]any, error){
+		"database":     checkDatabase,
+		"migrations":   checkMigrations,
+		"blob_storage": checkBlobStorage,
+	}
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		response = models.ReadinessResponse{Status: "ready", Checks: make(map[string]models.CheckResult)}
+	)
+	for name, check := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			start := time.Now()
+			details, err := check(ctx)
+			result := models.CheckResult{
+				Status:    models.StatusUp,
+				LatencyMS: time.Since(start).Milliseconds(),
+				Details:   details,
+			}
+			if err != nil {
+				result.Status = models.StatusDown
+				result.Error = err.Error()
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			response.Checks[name] = result
+			if err != nil {
+				response.Status = "not_ready"
+			}
+		}()
+	}
+	wg.Wait()
+
+	if response.Status != "ready" {
+		logger.Warn("Service is not ready", "checks", response.Checks)
+		c.JSON(http.StatusServiceUnavailable, response)
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+func checkDatabase(ctx context.Context) (map[string]any, error) {
+	if postgres.DB == nil {
+		return nil, fmt.Errorf("database is not connected")
+	}
+	return nil, postgres.Ping(ctx)
+}
+
+func checkMigrations(ctx context.Context) (map[string]any, error) {
+	if postgres.DB == nil {
+		return nil, fmt.Errorf("database is not connected")
+	}
+	version, err := postgres.SchemaVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+	details := map[string]any{"version": version, "expected": postgres.LatestVersion()}
+	if version != postgres.LatestVersion() {
+		return details, fmt.Errorf("schema version %d does not match expected %d", version, postgres.LatestVersion())
+	}
+	return details, nil
+}
+
+func checkBlobStorage(context.Context) (map[string]any, error) {
+	if storage.Blobs == nil {
+		return nil, fmt.Errorf("blob storage is not set up")
+	}
+	return nil, storage.Blobs.Ping()
+}
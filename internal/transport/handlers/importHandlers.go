@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"Music_Library/internal/database/postgres"
+	"Music_Library/internal/models"
+	"Music_Library/internal/tags"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"log/slog"
+	"net/http"
+)
+
+// ImportSong godoc
+//
+//	@Summary		Create a song from an audio file
+//	@Description	Reads ID3v2 (MP3) or Vorbis comment (FLAC) tags from the uploaded file and creates or updates the song with the same group and title. Unsynchronized lyrics are split into verses by blank lines and replace the stored ones.
+//	@Tags			songs
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Param			file	formData	file					true	"MP3 or FLAC file"
+//	@Success		200		{object}	models.ImportResponse	"Imported song and the fields taken from the file"
+//	@Failure		400		{object}	models.ErrorResponse	"Missing file or unsupported format"
+//	@Failure		413		{object}	models.ErrorResponse	"File is too large"
+//	@Failure		422		{object}	models.ErrorResponse	"File has no artist or title tags"
+//	@Failure		500		{object}	models.ErrorResponse	"Internal server error"
+//	@Router			/songs/upload [post]
+func ImportSong(c *gin.Context, logger *slog.Logger) {
+	disableTimeouts(c, logger)
+	limitBody(c, maxAudioSize)
+	header, err := c.FormFile("file")
+	if tooLarge(err) {
+		logger.Warn("Audio file is too large", "content_length", c.Request.ContentLength)
+		models.NewErrorResponse(c, 413, fmt.Sprintf("audio file must not exceed %d bytes", maxAudioSize))
+		return
+	}
+	if err != nil {
+		logger.Warn("Missing audio file", "error", err)
+		models.NewErrorResponse(c, 400, err.Error())
+		return
+	}
+	file, err := header.Open()
+	if err != nil {
+		abortWithError(c, logger, "Failed to open uploaded file", err)
+		return
+	}
+	defer file.Close()
+
+	meta, err := tags.Read(file)
+	if err != nil {
+		logger.Warn("Failed to read tags", "filename", header.Filename, "error", err)
+		models.NewErrorResponse(c, 400, err.Error())
+		return
+	}
+	if meta.Artist == "" || meta.Title == "" {
+		logger.Warn("Audio file has no artist or title", "filename", header.Filename)
+		models.NewErrorResponse(c, 422, "file has no artist or title tags")
+		return
+	}
+
+	song, fields := meta.Song()
+	created, err := postgres.ImportSong(c.Request.Context(), song)
+	if err != nil {
+		abortWithError(c, logger, "Error importing song", err)
+		return
+	}
+	imported, err := postgres.GetSong(c.Request.Context(), song.ID)
+	if err != nil {
+		abortWithError(c, logger, "Error fetching imported song", err, "id", song.ID)
+		return
+	}
+	logger.Info("Successfully imported song", "song_id", song.ID, "created", created, "fields", fields)
+	c.JSON(http.StatusOK, models.ImportResponse{
+		Song:           imported,
+		Created:        created,
+		FieldsFromFile: fields,
+	})
+}
@@ -28,10 +28,9 @@ func GetLyric(c *gin.Context, logger *slog.Logger) {
 		models.NewErrorResponse(c, 400, err.Error())
 		return
 	}
-	lyric, err := postgres.GetLyric(uint(id))
+	lyric, err := postgres.GetLyric(c.Request.Context(), uint(id))
 	if err != nil {
-		logger.Error("Invalid input format", "error", err)
-		models.NewErrorResponse(c, 500, err.Error())
+		abortWithError(c, logger, "Error fetching lyric", err, "id", id)
 		return
 	}
 	logger.Info("Successfully fetched lyric", "id", id)
@@ -41,13 +40,13 @@ func GetLyric(c *gin.Context, logger *slog.Logger) {
 
 // UpdateLyric godoc
 //
-//	@Summary		Update lyrics information
-//	@Description	Updates an existing lyric entry using its unique ID.
+//	@Summary		Replace lyrics information
+//	@Description	Replaces an existing lyric entry using its unique ID. All fields are required; use PATCH to change only some of them.
 //	@Tags			Lyrics
 //	@Accept			json
 //	@Produce		json
 //	@Param			id		path		int					    true	"Lyric ID"	Format(int)
-//	@Param			lyric	body		models.Lyric		    true	"Updated lyric object"
+//	@Param			lyric	body		models.Lyric		    true	"New lyric object"
 //	@Success		200		{object}	models.Lyric		    "Successfully updated lyrics"
 //	@Failure		400		{object}	models.ErrorResponse	"Invalid input format"
 //	@Failure		404		{object}	models.ErrorResponse	"Lyrics not found"
@@ -66,15 +65,9 @@ func UpdateLyric(c *gin.Context, logger *slog.Logger) {
 		return
 	}
 	logger.Info("Received update data", "lyric", updateLyric)
-	lyric, err := postgres.UpdateLyric(uint(id), &updateLyric)
+	lyric, err := postgres.ReplaceLyric(c.Request.Context(), uint(id), &updateLyric)
 	if err != nil {
-		if err.Error() == "record not found" {
-			logger.Warn("Lyric not found", "id", id)
-			models.NewErrorResponse(c, 404, err.Error())
-		} else {
-			logger.Error("Failed to update lyric", "error", err)
-			models.NewErrorResponse(c, 500, err.Error())
-		}
+		abortWithError(c, logger, "Failed to update lyric", err, "id", id)
 		return
 	}
 	logger.Info("Successfully updated lyric", "id", id, "lyric", lyric)
@@ -82,6 +75,48 @@ func UpdateLyric(c *gin.Context, logger *slog.Logger) {
 
 }
 
+// PatchLyric godoc
+//
+//	@Summary		Partially update a lyric
+//	@Description	Changes some fields of a lyric with a JSON Merge Patch (RFC 7396) or a JSON Patch (RFC 6902), chosen by Content-Type. A null in a merge patch or a remove operation clears the field.
+//	@Tags			Lyrics
+//	@Accept			application/merge-patch+json
+//	@Accept			application/json-patch+json
+//	@Produce		json
+//	@Param			id		path		int					    true	"Lyric ID"	Format(int)
+//	@Param			patch	body		object				    true	"Merge patch object or JSON Patch operations"
+//	@Success		200		{object}	models.Lyric		    "Successfully updated lyrics"
+//	@Failure		400		{object}	models.ErrorResponse	"Invalid lyric ID, malformed patch or invalid result"
+//	@Failure		404		{object}	models.ErrorResponse	"Lyrics not found"
+//	@Failure		409		{object}	models.ErrorResponse	"A test operation failed"
+//	@Failure		415		{object}	models.ErrorResponse	"Unsupported Content-Type"
+//	@Failure		422		{object}	models.ErrorResponse	"The patch cannot be applied to the lyric"
+//	@Router			/lyrics/{id} [patch]
+func PatchLyric(c *gin.Context, logger *slog.Logger) {
+	c.Header("Accept-Patch", acceptPatch)
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		logger.Warn("Invalid ID format", "error", err, "id", c.Param("id"))
+		models.NewErrorResponse(c, 400, err.Error())
+		return
+	}
+	p, err := readPatch(c)
+	if err != nil {
+		abortWithError(c, logger, "Invalid lyric patch", err, "id", id)
+		return
+	}
+
+	lyric, err := postgres.PatchLyric(c.Request.Context(), uint(id), func(lyric *models.Lyric) error {
+		return applyPatch(p, lyric)
+	})
+	if err != nil {
+		abortWithError(c, logger, "Failed to patch lyric", err, "id", id)
+		return
+	}
+	logger.Info("Successfully patched lyric", "id", id, "lyric", lyric)
+	c.JSON(http.StatusOK, gin.H{"lyric": lyric})
+}
+
 // DeleteLyric godoc
 //
 //	@Summary		Delete a lyric entry
@@ -101,15 +136,9 @@ func DeleteLyric(c *gin.Context, logger *slog.Logger) {
 		models.NewErrorResponse(c, 400, err.Error())
 		return
 	}
-	err = postgres.DeleteLyric(uint(id))
+	err = postgres.DeleteLyric(c.Request.Context(), uint(id))
 	if err != nil {
-		if err.Error() == "record not found" {
-			logger.Warn("Song not found for deletion", "id", id)
-			models.NewErrorResponse(c, 404, err.Error())
-		} else {
-			logger.Error("Error deleting lyric", "id", id, "error", err)
-			models.NewErrorResponse(c, 500, err.Error())
-		}
+		abortWithError(c, logger, "Error deleting lyric", err, "id", id)
 		return
 	}
 	logger.Info("Successfully deleted lyric", "id", id)
@@ -126,7 +155,8 @@ func DeleteLyric(c *gin.Context, logger *slog.Logger) {
 //	@Produce		json
 //	@Param			lyric	body		models.Lyric		true	"Lyric object containing song ID and text"
 //	@Success		201		{object}	models.Lyric		"Successfully created lyric entry"
-//	@Failure		400		{object}	models.ErrorResponse
+//	@Failure		400		{object}	models.ErrorResponse	"Invalid input or the song does not exist"
+//	@Failure		500		{object}	models.ErrorResponse	"Internal server error"
 //	@Router			/lyrics [post]
 func AddLyric(c *gin.Context, logger *slog.Logger) {
 	var newLyric models.Lyric
@@ -136,14 +166,169 @@ func AddLyric(c *gin.Context, logger *slog.Logger) {
 		return
 	}
 	logger.Info("Received new song", "song", newLyric)
-	err := postgres.AddLyric(&newLyric)
+	err := postgres.AddLyric(c.Request.Context(), &newLyric)
 	if err != nil {
-		logger.Error("Error adding lyric", "error", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		models.NewErrorResponse(c, 500, err.Error())
+		abortWithError(c, logger, "Error adding lyric", err)
 		return
 	}
 	logger.Info("Successfully added new lyric", "song_id", newLyric.ID)
 	c.JSON(http.StatusOK, gin.H{"lyric": newLyric})
 
 }
+
+// GetSongLyrics godoc
+//
+//	@Summary		List the verses of a song
+//	@Description	Returns the lyrics of a song ordered by verse number. Pagination is supported with offset and page_size parameters, counted in verses.
+//	@Tags			Lyrics
+//	@Produce		json
+//	@Param			id			path		int						true	"Song ID"
+//	@Param			offset		query		int						false	"Number of verses to skip (default: 0)"
+//	@Param			page_size	query		int						false	"Number of verses per page, at most 100 (default: 10)"
+//	@Success		200			{array}		models.Lyric			"Verses with pagination metadata"
+//	@Failure		400			{object}	models.ErrorResponse	"Invalid song ID, offset or page size"
+//	@Failure		404			{object}	models.ErrorResponse	"Song not found"
+//	@Router			/songs/{id}/lyrics [get]
+func GetSongLyrics(c *gin.Context, logger *slog.Logger) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		logger.Warn("Invalid song ID", "id", c.Param("id"), "error", err)
+		models.NewErrorResponse(c, 400, err.Error())
+		return
+	}
+	offset, pageSize, fields := parsePage(c, defaultPageSize)
+	if len(fields) > 0 {
+		logger.Warn("Invalid lyrics pagination", "errors", fields)
+		models.NewProblem(c, http.StatusBadRequest, models.CodeValidationFailed, "invalid pagination", fields)
+		return
+	}
+
+	lyrics, total, err := postgres.GetSongLyrics(c.Request.Context(), uint(id), offset, pageSize)
+	if err != nil {
+		abortWithError(c, logger, "Error fetching song lyrics", err, "song_id", id)
+		return
+	}
+	logger.Info("Successfully fetched song lyrics", "song_id", id, "total", total)
+	c.JSON(http.StatusOK, gin.H{
+		"data": lyrics,
+		"pagination": gin.H{
+			"total":     total,
+			"offset":    offset,
+			"page_size": pageSize,
+		},
+	})
+}
+
+// GetSongVerse godoc
+//
+//	@Summary		Get one verse of a song
+//	@Description	Returns the verse of a song with the given verse number.
+//	@Tags			Lyrics
+//	@Produce		json
+//	@Param			id				path		int						true	"Song ID"
+//	@Param			verse_number	path		int						true	"Verse number"
+//	@Success		200				{object}	models.Lyric			"The verse"
+//	@Failure		400				{object}	models.ErrorResponse	"Invalid song ID or verse number"
+//	@Failure		404				{object}	models.ErrorResponse	"Song or verse not found"
+//	@Router			/songs/{id}/lyrics/{verse_number} [get]
+func GetSongVerse(c *gin.Context, logger *slog.Logger) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		logger.Warn("Invalid song ID", "id", c.Param("id"), "error", err)
+		models.NewErrorResponse(c, 400, err.Error())
+		return
+	}
+	verse, err := strconv.Atoi(c.Param("verse_number"))
+	if err != nil {
+		logger.Warn("Invalid verse number", "verse_number", c.Param("verse_number"), "error", err)
+		models.NewErrorResponse(c, 400, err.Error())
+		return
+	}
+
+	lyric, err := postgres.GetLyricByVerse(c.Request.Context(), uint(id), verse)
+	if err != nil {
+		abortWithError(c, logger, "Error fetching verse", err, "song_id", id, "verse_number", verse)
+		return
+	}
+	logger.Info("Successfully fetched verse", "song_id", id, "verse_number", verse)
+	c.JSON(http.StatusOK, gin.H{"lyric": lyric})
+}
+
+// AddSongLyric godoc
+//
+//	@Summary		Add a verse to a song
+//	@Description	Adds a verse to the song from the path. song_id may be omitted from the body; if given, it must match the path. A verse number that is already taken is a conflict unless insert is true: then the verse is inserted at that number and the verses from it on are moved one number down.
+//	@Tags			Lyrics
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int						true	"Song ID"
+//	@Param			insert	query		bool					false	"Shift later verses to make room (default: false)"
+//	@Param			lyric	body		models.Lyric			true	"Verse number and text"
+//	@Success		201		{object}	models.Lyric			"Created verse"
+//	@Failure		400		{object}	models.ErrorResponse	"Invalid input"
+//	@Failure		404		{object}	models.ErrorResponse	"Song not found"
+//	@Failure		409		{object}	models.ErrorResponse	"Verse number is taken"
+//	@Router			/songs/{id}/lyrics [post]
+func AddSongLyric(c *gin.Context, logger *slog.Logger) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		logger.Warn("Invalid song ID", "id", c.Param("id"), "error", err)
+		models.NewErrorResponse(c, 400, err.Error())
+		return
+	}
+	insert, err := strconv.ParseBool(c.DefaultQuery("insert", "false"))
+	if err != nil {
+		logger.Warn("Invalid insert parameter", "insert", c.Query("insert"), "error", err)
+		models.NewErrorResponse(c, 400, err.Error())
+		return
+	}
+	var lyric models.Lyric
+	if err = c.ShouldBindJSON(&lyric); err != nil {
+		logger.Warn("Invalid input for new lyric", "error", err)
+		models.NewErrorResponse(c, 400, err.Error())
+		return
+	}
+
+	if err = postgres.AddSongLyric(c.Request.Context(), uint(id), &lyric, insert); err != nil {
+		abortWithError(c, logger, "Error adding lyric", err, "song_id", id)
+		return
+	}
+	logger.Info("Successfully added lyric", "song_id", id, "id", lyric.ID, "insert", insert)
+	c.JSON(http.StatusCreated, gin.H{"lyric": lyric})
+}
+
+// ReorderSongLyrics godoc
+//
+//	@Summary		Reorder the verses of a song
+//	@Description	Renumbers the verses of a song from 1 in the order of ids in one transaction. ids must list every verse of the song exactly once.
+//	@Tags			Lyrics
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int						true	"Song ID"
+//	@Param			order	body		models.LyricOrder		true	"Verse IDs in the new order"
+//	@Success		200		{array}		models.Lyric			"Verses in the new order"
+//	@Failure		400		{object}	models.ErrorResponse	"Invalid input or ids does not list every verse of the song once"
+//	@Failure		404		{object}	models.ErrorResponse	"Song not found"
+//	@Router			/songs/{id}/lyrics/reorder [post]
+func ReorderSongLyrics(c *gin.Context, logger *slog.Logger) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		logger.Warn("Invalid song ID", "id", c.Param("id"), "error", err)
+		models.NewErrorResponse(c, 400, err.Error())
+		return
+	}
+	var order models.LyricOrder
+	if err = c.ShouldBindJSON(&order); err != nil {
+		logger.Warn("Invalid input for verse order", "error", err)
+		models.NewErrorResponse(c, 400, err.Error())
+		return
+	}
+
+	lyrics, err := postgres.ReorderLyrics(c.Request.Context(), uint(id), order.IDs)
+	if err != nil {
+		abortWithError(c, logger, "Error reordering lyrics", err, "song_id", id)
+		return
+	}
+	logger.Info("Successfully reordered lyrics", "song_id", id, "verses", len(lyrics))
+	c.JSON(http.StatusOK, gin.H{"data": lyrics})
+}
@@ -0,0 +1,30 @@
+package handlers
+
+import (
+	"Music_Library/internal/models"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"strconv"
+)
+
+const (
+	// defaultPageSize — page_size списков REST API, если он не указан.
+	defaultPageSize = 10
+	// maxPageSize — наибольшее число элементов на странице списков REST API.
+	maxPageSize = 100
+)
+
+// parsePage читает offset и page_size страницы списка и возвращает ошибки полей,
+// если они не целые или выходят за допустимые пределы. defaultSize — page_size по умолчанию.
+func parsePage(c *gin.Context, defaultSize int) (offset, pageSize int, fields []models.FieldError) {
+	offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if err != nil || offset < 0 {
+		fields = append(fields, models.FieldError{Field: "offset", Code: models.CodeInvalid, Message: "must be a non-negative integer"})
+	}
+	pageSize, err = strconv.Atoi(c.DefaultQuery("page_size", strconv.Itoa(defaultSize)))
+	if err != nil || pageSize <= 0 || pageSize > maxPageSize {
+		fields = append(fields, models.FieldError{Field: "page_size", Code: models.CodeInvalid,
+			Message: fmt.Sprintf("must be an integer from 1 to %d", maxPageSize)})
+	}
+	return offset, pageSize, fields
+}
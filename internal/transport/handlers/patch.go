@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/evanphx/json-patch/v5"
+	"github.com/gin-gonic/gin"
+	"io"
+	"net/http"
+)
+
+const (
+	mergePatchContentType = "application/merge-patch+json"
+	jsonPatchContentType  = "application/json-patch+json"
+)
+
+// acceptPatch — значение заголовка Accept-Patch для ресурсов, поддерживающих PATCH.
+const acceptPatch = mergePatchContentType + ", " + jsonPatchContentType
+
+// patch — тело запроса PATCH вместе с его типом.
+type patch struct {
+	contentType string
+	body        []byte
+	operations  jsonpatch.Patch
+}
+
+// readPatch читает тело запроса PATCH.
+func readPatch(c *gin.Context) (*patch, error) {
+	contentType := c.ContentType()
+	if contentType != mergePatchContentType && contentType != jsonPatchContentType {
+		return nil, &statusError{status: http.StatusUnsupportedMediaType,
+			err: fmt.Errorf("unsupported content type %q, must be %s", contentType, acceptPatch)}
+	}
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		return nil, &statusError{status: http.StatusBadRequest, err: err}
+	}
+	return newPatch(contentType, body)
+}
+
+// newPatch разбирает патч типа contentType. JSON Patch разбирается сразу, чтобы ошибку в нём
+// вернуть до обращения к базе.
+func newPatch(contentType string, body []byte) (*patch, error) {
+	p := &patch{contentType: contentType, body: body}
+	var err error
+	if p.contentType == jsonPatchContentType {
+		if p.operations, err = jsonpatch.DecodePatch(p.body); err != nil {
+			return nil, &statusError{status: http.StatusBadRequest, err: fmt.Errorf("invalid JSON patch: %w", err)}
+		}
+	} else if !json.Valid(p.body) {
+		return nil, &statusError{status: http.StatusBadRequest, err: errors.New("invalid merge patch: body is not valid JSON")}
+	}
+	return p, nil
+}
+
+// applyPatch применяет патч к JSON-представлению v и записывает результат обратно в v.
+// Поля, удалённые патчем или заданные как null, получают нулевые значения.
+func applyPatch[T any](p *patch, v *T) error {
+	doc, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if p.contentType == jsonPatchContentType {
+		doc, err = p.operations.Apply(doc)
+	} else {
+		doc, err = jsonpatch.MergePatch(doc, p.body)
+	}
+	switch {
+	case errors.Is(err, jsonpatch.ErrTestFailed):
+		return &statusError{status: http.StatusConflict, err: err}
+	case err != nil:
+		return &statusError{status: http.StatusUnprocessableEntity, err: err}
+	}
+
+	// Результат разбирается в пустую структуру, чтобы удалённые поля не сохранили старые значения.
+	var patched T
+	if err = json.Unmarshal(doc, &patched); err != nil {
+		return &statusError{status: http.StatusUnprocessableEntity, err: fmt.Errorf("patched document is invalid: %w", err)}
+	}
+	*v = patched
+	return nil
+}
@@ -3,6 +3,8 @@ package handlers
 import (
 	"Music_Library/internal/database/postgres"
 	"Music_Library/internal/models"
+	"Music_Library/internal/songinfo"
+	"Music_Library/internal/tags"
 	"github.com/gin-gonic/gin"
 	"log/slog"
 	"net/http"
@@ -12,37 +14,93 @@ import (
 // GetAllSongs godoc
 //
 //	@Summary		Get all songs
-//	@Description	Fetch a list of all songs, with optional filters for group, title, release date, and link. Pagination is supported with offset and page_size parameters.
+//	@Description	Fetch a list of all songs, with optional filters for group, title, release date, and link. Pagination is supported with offset and page_size parameters, and the order is set with sort. Lyrics are returned only with include=lyrics.
 //	@Tags			songs
 //	@Accept			json
 //	@Produce		json
+//	@Param			ids				query		string					false	"Comma-separated song IDs to fetch in one query, at most 100; page_size defaults to their number"
 //	@Param			group			query		string					false	"Filter songs by group name"
 //	@Param			title			query		string					false	"Filter songs by title"
-//	@Param			release_date	query		string					false	"Filter songs by release date (YYYY-MM-DD)"
+//	@Param			release_date	query		string					false	"Filter songs by exact release date and precision (YYYY-MM-DD, YYYY-MM or YYYY)"
+//	@Param			released_after	query		string					false	"Only songs released on or after this date; a year or month means its first day"
+//	@Param			released_before	query		string					false	"Only songs released on or before this date; a year or month means its last day"
+//	@Param			year			query		int						false	"Only songs released in this year"
 //	@Param			link			query		string					false	"Filter songs by associated link"
+//	@Param			sort			query		string					false	"Comma-separated fields to sort by, prefixed with - for descending order: id, group, title, release_date, link, lyric_count (default: id)"
+//	@Param			fields			query		string					false	"Comma-separated song fields to return: group, title, release_date, link (default: all; ID is always returned)"
+//	@Param			include			query		string					false	"Comma-separated relations to load: lyrics, artwork (default: none)"
 //	@Param			offset			query		int						false	"Pagination offset, starting from 0 (default: 0)"
-//	@Param			page_size		query		int						false	"Number of items per page (default: 10)"
+//	@Param			page_size		query		int						false	"Number of items per page, at most 100 (default: 10)"
 //	@Success		200				{object}	[]models.Song       	"List of songs with pagination metadata"
+//	@Failure		400				{object}	models.ErrorResponse	"Invalid filter, sort, fields, include, offset or page size"
 //	@Failure		500				{object}	models.ErrorResponse	"Internal server error"
 //	@Router			/songs [get]
 func GetAllSongs(c *gin.Context, logger *slog.Logger) {
-	group := c.Query("group")
-	title := c.Query("title")
-	releaseDate := c.Query("release_date")
-	link := c.Query("link")
+	filter := postgres.SongFilter{
+		Group:          c.Query("group"),
+		Title:          c.Query("title"),
+		Link:           c.Query("link"),
+		ReleaseDate:    models.NewReleaseDate(c.Query("release_date")),
+		ReleasedAfter:  models.NewReleaseDate(c.Query("released_after")),
+		ReleasedBefore: models.NewReleaseDate(c.Query("released_before")),
+	}
+	if ids := c.Query("ids"); ids != "" {
+		var err error
+		if filter.IDs, err = parseIDs(ids); err != nil {
+			logger.Warn("Invalid ids filter", "ids", ids)
+			models.NewProblem(c, http.StatusBadRequest, models.CodeValidationFailed, "invalid song filter",
+				[]models.FieldError{{Field: "ids", Code: models.CodeInvalid, Message: "must be comma-separated positive integers"}})
+			return
+		}
+	}
+	if year := c.Query("year"); year != "" {
+		y, err := strconv.Atoi(year)
+		if err != nil {
+			logger.Warn("Invalid year filter", "year", year)
+			models.NewProblem(c, http.StatusBadRequest, models.CodeValidationFailed, "invalid song filter",
+				[]models.FieldError{{Field: "year", Code: models.CodeInvalid, Message: "must be an integer"}})
+			return
+		}
+		filter.Year = &y
+	}
+
+	order, err := postgres.ParseSongSort(c.Query("sort"))
+	if err != nil {
+		abortWithError(c, logger, "Invalid song sort", err, "sort", c.Query("sort"))
+		return
+	}
 
-	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
-	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+	fields, err := songFields(c, "")
+	if err != nil {
+		abortWithError(c, logger, "Invalid song fields", err, "fields", c.Query("fields"), "include", c.Query("include"))
+		return
+	}
 
-	songs, total, err := postgres.GetAllSongs(group, title, releaseDate, link, offset, pageSize)
+	defaultSize := defaultPageSize
+	if len(filter.IDs) > 0 {
+		// Перечисленные песни возвращаются одной страницей.
+		defaultSize = len(filter.IDs)
+	}
+	offset, pageSize, pageErrors := parsePage(c, defaultSize)
+	if len(pageErrors) > 0 {
+		logger.Warn("Invalid songs pagination", "errors", pageErrors)
+		models.NewProblem(c, http.StatusBadRequest, models.CodeValidationFailed, "invalid pagination", pageErrors)
+		return
+	}
+
+	songs, total, err := postgres.GetAllSongs(c.Request.Context(), filter, order, fields, offset, pageSize)
 	if err != nil {
-		logger.Error("Error fetching songs", "error", err)
-		models.NewErrorResponse(c, 500, err.Error())
+		abortWithError(c, logger, "Error fetching songs", err)
+		return
+	}
+	data, err := projectSongs(songs, fields)
+	if err != nil {
+		abortWithError(c, logger, "Error encoding songs", err)
 		return
 	}
 	logger.Info("Successfully fetched songs", "total", total)
 	c.JSON(http.StatusOK, gin.H{
-		"data": songs,
+		"data": data,
 		"pagination": gin.H{
 			"total":     total,
 			"offset":    offset,
@@ -58,10 +116,12 @@ func GetAllSongs(c *gin.Context, logger *slog.Logger) {
 //	@Tags			songs
 //	@Accept			json
 //	@Produce		json
-//	@Param			id	path		int				    	true	"ID of the song"
-//	@Success		200	{object}	models.Song			    "Song details"
-//	@Failure		400	{object}	models.ErrorResponse	"Invalid song ID"
-//	@Failure		404	{object}	models.ErrorResponse	"Song not found"
+//	@Param			id		path		int				    	true	"ID of the song"
+//	@Param			fields	query		string					false	"Comma-separated song fields to return: group, title, release_date, link (default: all; ID is always returned)"
+//	@Param			include	query		string					false	"Comma-separated relations to load: lyrics, artwork (default: lyrics,artwork)"
+//	@Success		200		{object}	models.Song			    "Song details"
+//	@Failure		400		{object}	models.ErrorResponse	"Invalid song ID, fields or include"
+//	@Failure		404		{object}	models.ErrorResponse	"Song not found"
 //	@Router			/songs/{id} [get]
 func GetSong(c *gin.Context, logger *slog.Logger) {
 	id, err := strconv.Atoi(c.Param("id"))
@@ -70,20 +130,29 @@ func GetSong(c *gin.Context, logger *slog.Logger) {
 		models.NewErrorResponse(c, 400, err.Error())
 		return
 	}
-	song, err := postgres.GetSong(uint(id))
+	fields, err := songFields(c, "lyrics,artwork")
 	if err != nil {
-		logger.Error("Error fetching song", "id", id, "error", err)
-		models.NewErrorResponse(c, 500, err.Error())
+		abortWithError(c, logger, "Invalid song fields", err, "fields", c.Query("fields"), "include", c.Query("include"))
+		return
+	}
+	song, err := postgres.GetSongWithFields(c.Request.Context(), uint(id), fields)
+	if err != nil {
+		abortWithError(c, logger, "Error fetching song", err, "id", id)
+		return
+	}
+	data, err := projectSong(song, fields)
+	if err != nil {
+		abortWithError(c, logger, "Error encoding song", err, "id", id)
 		return
 	}
 	logger.Info("Successfully fetched song", "id", id)
-	c.JSON(http.StatusOK, gin.H{"song": song})
+	c.JSON(http.StatusOK, gin.H{"song": data})
 }
 
 // AddSong godoc
 //
 //	@Summary		Add a new song
-//	@Description	Add a new song to the database
+//	@Description	Add a new song to the database. Missing release date, link and lyrics are filled in from the song info service when it is configured.
 //	@Tags			songs
 //	@Accept			json
 //	@Produce		json
@@ -99,10 +168,10 @@ func AddSong(c *gin.Context, logger *slog.Logger) {
 		return
 	}
 	logger.Info("Received new song", "song", newSong)
-	err := postgres.AddSong(&newSong)
+	enrichSong(c, logger, &newSong)
+	err := postgres.AddSong(c.Request.Context(), &newSong)
 	if err != nil {
-		logger.Error("Error adding song", "error", err)
-		models.NewErrorResponse(c, 500, err.Error())
+		abortWithError(c, logger, "Error adding song", err)
 		return
 	}
 	logger.Info("Successfully added new song", "song_id", newSong.ID)
@@ -112,7 +181,7 @@ func AddSong(c *gin.Context, logger *slog.Logger) {
 // DeleteSong godoc
 //
 //	@Summary		Delete a song
-//	@Description	Delete a song from the database using its ID
+//	@Description	Delete a song from the database using its ID, together with its lyrics, audio file and cover art. Stored files that no other song uses are deleted from blob storage.
 //	@Tags			songs
 //	@Accept			json
 //	@Produce		json
@@ -128,15 +197,9 @@ func DeleteSong(c *gin.Context, logger *slog.Logger) {
 		models.NewErrorResponse(c, 400, err.Error())
 		return
 	}
-	err = postgres.DeleteSong(uint(id))
+	err = postgres.DeleteSong(c.Request.Context(), uint(id))
 	if err != nil {
-		if err.Error() == "record not found" {
-			logger.Warn("Song not found for deletion", "id", id)
-			models.NewErrorResponse(c, 404, err.Error())
-		} else {
-			logger.Error("Error deleting song", "id", id, "error", err)
-			models.NewErrorResponse(c, 500, err.Error())
-		}
+		abortWithError(c, logger, "Error deleting song", err, "id", id)
 		return
 	}
 	logger.Info("Successfully deleted song", "id", id)
@@ -145,13 +208,13 @@ func DeleteSong(c *gin.Context, logger *slog.Logger) {
 
 // UpdateSong godoc
 //
-//	@Summary		Update an existing song
-//	@Description	Update song details by its ID, such as title, group, or release date
+//	@Summary		Replace an existing song
+//	@Description	Replace the song with the given ID. Fields missing from the body are cleared, lyrics with an ID are updated, lyrics without one are added and the song's other lyrics are deleted. Use PATCH to change only some fields.
 //	@Tags			songs
 //	@Accept			json
 //	@Produce		json
-//	@Param			id		path		int					    true	"ID of the song to be updated"
-//	@Param			song	body		models.Song			    true	"Updated song details"
+//	@Param			id		path		int					    true	"ID of the song to be replaced"
+//	@Param			song	body		models.Song			    true	"New song details"
 //	@Success		200		{object}	models.Song			    "Updated song details"
 //	@Failure		400		{object}	models.ErrorResponse	"Invalid input data"
 //	@Failure		404		{object}	models.ErrorResponse	"Song not found"
@@ -172,17 +235,83 @@ func UpdateSong(c *gin.Context, logger *slog.Logger) {
 
 	logger.Info("Received update data", "song", updateSong)
 
-	song, err := postgres.UpdateSong(uint(id), &updateSong)
+	song, err := postgres.ReplaceSong(c.Request.Context(), uint(id), &updateSong)
 	if err != nil {
-		if err.Error() == "record not found" {
-			logger.Warn("Song not found for update", "id", id)
-			models.NewErrorResponse(c, 404, err.Error())
-		} else {
-			logger.Error("Error updating song", "id", id, "error", err)
-			models.NewErrorResponse(c, 500, err.Error())
-		}
+		abortWithError(c, logger, "Error updating song", err, "id", id)
 		return
 	}
 	logger.Info("Successfully updated song", "id", id, "song", song)
 	c.JSON(http.StatusOK, gin.H{"song": song})
 }
+
+// PatchSong godoc
+//
+//	@Summary		Partially update a song
+//	@Description	Change some fields of the song with a JSON Merge Patch (RFC 7396) or a JSON Patch (RFC 6902), chosen by Content-Type. A null in a merge patch or a remove operation clears the field. The patch is applied to the song with its lyrics, and lyrics are saved as with PUT.
+//	@Tags			songs
+//	@Accept			application/merge-patch+json
+//	@Accept			application/json-patch+json
+//	@Produce		json
+//	@Param			id		path		int					    true	"ID of the song to be updated"
+//	@Param			patch	body		object				    true	"Merge patch object or JSON Patch operations"
+//	@Success		200		{object}	models.Song			    "Updated song details"
+//	@Failure		400		{object}	models.ErrorResponse	"Invalid song ID, malformed patch or invalid result"
+//	@Failure		404		{object}	models.ErrorResponse	"Song not found"
+//	@Failure		409		{object}	models.ErrorResponse	"A test operation failed"
+//	@Failure		415		{object}	models.ErrorResponse	"Unsupported Content-Type"
+//	@Failure		422		{object}	models.ErrorResponse	"The patch cannot be applied to the song"
+//	@Router			/songs/{id} [patch]
+func PatchSong(c *gin.Context, logger *slog.Logger) {
+	c.Header("Accept-Patch", acceptPatch)
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		logger.Warn("Invalid song ID for patch", "id", c.Param("id"), "error", err)
+		models.NewErrorResponse(c, 400, err.Error())
+		return
+	}
+	p, err := readPatch(c)
+	if err != nil {
+		abortWithError(c, logger, "Invalid song patch", err, "id", id)
+		return
+	}
+
+	song, err := postgres.PatchSong(c.Request.Context(), uint(id), func(song *models.Song) error {
+		return applyPatch(p, song)
+	})
+	if err != nil {
+		abortWithError(c, logger, "Error patching song", err, "id", id)
+		return
+	}
+	logger.Info("Successfully patched song", "id", id, "song", song)
+	c.JSON(http.StatusOK, gin.H{"song": song})
+}
+
+// enrichSong дополняет песню данными из внешнего сервиса. Ошибки сервиса не мешают
+// добавить песню, поэтому они только логируются.
+func enrichSong(c *gin.Context, logger *slog.Logger, song *models.Song) {
+	if songinfo.Default == nil || song.Group == "" || song.Title == "" || (!song.ReleaseDate.IsZero() && song.Link != "" && len(song.Lyrics) > 0) {
+		return
+	}
+	detail, err := songinfo.Default.Info(c.Request.Context(), song.Group, song.Title)
+	if err != nil {
+		logger.Warn("Failed to fetch song info", "group", song.Group, "title", song.Title, "error", err)
+		return
+	}
+	if song.ReleaseDate.IsZero() {
+		// Дату в незнакомом формате пропускаем, чтобы из-за неё не отклонить всю песню.
+		if date, err := models.ParseReleaseDate(detail.ReleaseDate); err == nil {
+			song.ReleaseDate = date
+		} else {
+			logger.Warn("Song info service returned an invalid release date", "release_date", detail.ReleaseDate)
+		}
+	}
+	if song.Link == "" {
+		song.Link = detail.Link
+	}
+	if len(song.Lyrics) == 0 {
+		for i, verse := range tags.SplitVerses(detail.Text) {
+			song.Lyrics = append(song.Lyrics, models.Lyric{VerseNumber: i + 1, Text: verse})
+		}
+	}
+	logger.Info("Song enriched from song info service", "group", song.Group, "title", song.Title)
+}
@@ -0,0 +1,21 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// disableTimeouts снимает таймауты чтения и записи сервера для текущего запроса.
+// Нужен загрузкам больших файлов и долгим потокам, которые не укладываются в http_server.read_timeout
+// и http_server.write_timeout.
+func disableTimeouts(c *gin.Context, logger *slog.Logger) {
+	rc := http.NewResponseController(c.Writer)
+	if err := rc.SetReadDeadline(time.Time{}); err != nil {
+		logger.Warn("Failed to clear read deadline", "error", err)
+	}
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		logger.Warn("Failed to clear write deadline", "error", err)
+	}
+}
@@ -0,0 +1,22 @@
+package handlers
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+// multipartOverhead — запас на заголовки частей и границы multipart сверх размера самого файла.
+const multipartOverhead = 1 << 20
+
+// limitBody ограничивает тело запроса limit байтами файла и multipartOverhead. Вызывается до
+// c.FormFile: тот сохраняет всё тело на диск раньше, чем можно проверить размер файла.
+func limitBody(c *gin.Context, limit int64) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, limit+multipartOverhead)
+}
+
+// tooLarge сообщает, что тело запроса превысило предел limitBody.
+func tooLarge(err error) bool {
+	var maxBytesErr *http.MaxBytesError
+	return errors.As(err, &maxBytesErr)
+}
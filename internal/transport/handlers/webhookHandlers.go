@@ -0,0 +1,226 @@
+package handlers
+
+import (
+	"Music_Library/internal/database/postgres"
+	"Music_Library/internal/events"
+	"Music_Library/internal/models"
+	"Music_Library/internal/webhooks"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// AddWebhook godoc
+//
+//	@Summary		Subscribe to catalogue changes
+//	@Description	Creates a webhook that receives signed JSON payloads for the given event types (song.created, song.updated, song.deleted, lyric.created, lyric.updated, lyric.deleted). Patterns such as "song.*" and "*" are accepted. If no secret is sent, one is generated; it is returned only in this response.
+//	@Tags			webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Param			webhook	body		models.WebhookInput		true	"Webhook URL, event types and optional secret"
+//	@Success		200		{object}	models.Webhook			"Created webhook with its secret"
+//	@Failure		400		{object}	models.ErrorResponse	"Invalid URL or event types"
+//	@Router			/webhooks [post]
+func AddWebhook(c *gin.Context, logger *slog.Logger) {
+	var input models.WebhookInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		logger.Error("Invalid input for new webhook", "error", err)
+		models.NewErrorResponse(c, 400, err.Error())
+		return
+	}
+	// ID, активность и время создания задаёт сервер, поэтому из тела берутся только эти поля.
+	webhook := models.Webhook{URL: input.URL, Events: input.Events, Secret: input.Secret}
+	if err := validateWebhook(&webhook); err != nil {
+		logger.Warn("Invalid webhook", "error", err)
+		models.NewErrorResponse(c, 400, err.Error())
+		return
+	}
+	if webhook.Secret == "" {
+		webhook.Secret = webhooks.NewSecret()
+	}
+	if err := postgres.AddWebhook(c.Request.Context(), &webhook); err != nil {
+		abortWithError(c, logger, "Error adding webhook", err)
+		return
+	}
+	logger.Info("Successfully added webhook", "webhook_id", webhook.ID, "url", webhook.URL)
+	c.JSON(http.StatusOK, gin.H{"webhook": webhook})
+}
+
+// GetWebhooks godoc
+//
+//	@Summary		List webhooks
+//	@Description	Returns all webhook subscriptions without their secrets
+//	@Tags			webhooks
+//	@Produce		json
+//	@Success		200	{array}		models.Webhook			"Webhooks"
+//	@Failure		500	{object}	models.ErrorResponse	"Internal server error"
+//	@Router			/webhooks [get]
+func GetWebhooks(c *gin.Context, logger *slog.Logger) {
+	list, err := postgres.GetWebhooks(c.Request.Context())
+	if err != nil {
+		abortWithError(c, logger, "Error fetching webhooks", err)
+		return
+	}
+	for i := range list {
+		list[i].Secret = ""
+	}
+	c.JSON(http.StatusOK, gin.H{"data": list})
+}
+
+// GetWebhook godoc
+//
+//	@Summary		Get webhook by ID
+//	@Description	Returns a webhook subscription without its secret
+//	@Tags			webhooks
+//	@Produce		json
+//	@Param			id	path		int						true	"Webhook ID"
+//	@Success		200	{object}	models.Webhook			"Webhook"
+//	@Failure		400	{object}	models.ErrorResponse	"Invalid webhook ID"
+//	@Failure		404	{object}	models.ErrorResponse	"Webhook not found"
+//	@Router			/webhooks/{id} [get]
+func GetWebhook(c *gin.Context, logger *slog.Logger) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		logger.Warn("Invalid webhook ID", "id", c.Param("id"), "error", err)
+		models.NewErrorResponse(c, 400, err.Error())
+		return
+	}
+	webhook, err := postgres.GetWebhook(c.Request.Context(), uint(id))
+	if err != nil {
+		abortWithError(c, logger, "Error fetching webhook", err, "id", id)
+		return
+	}
+	webhook.Secret = ""
+	c.JSON(http.StatusOK, gin.H{"webhook": webhook})
+}
+
+// DeleteWebhook godoc
+//
+//	@Summary		Delete a webhook
+//	@Description	Removes a webhook subscription together with its delivery log
+//	@Tags			webhooks
+//	@Produce		json
+//	@Param			id	path		int						true	"Webhook ID"
+//	@Success		200	{object}	models.Response			"ID of the deleted webhook"
+//	@Failure		400	{object}	models.ErrorResponse	"Invalid webhook ID"
+//	@Failure		404	{object}	models.ErrorResponse	"Webhook not found"
+//	@Router			/webhooks/{id} [delete]
+func DeleteWebhook(c *gin.Context, logger *slog.Logger) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		logger.Warn("Invalid webhook ID for deletion", "id", c.Param("id"), "error", err)
+		models.NewErrorResponse(c, 400, err.Error())
+		return
+	}
+	err = postgres.DeleteWebhook(c.Request.Context(), uint(id))
+	if err != nil {
+		abortWithError(c, logger, "Error deleting webhook", err, "id", id)
+		return
+	}
+	logger.Info("Successfully deleted webhook", "id", id)
+	models.NewResponse(c, id, "successfully deleted")
+}
+
+// GetWebhookDeliveries godoc
+//
+//	@Summary		Get webhook delivery log
+//	@Description	Returns delivery attempts of a webhook, newest first. Pagination is supported with offset and page_size parameters.
+//	@Tags			webhooks
+//	@Produce		json
+//	@Param			id			path		int							true	"Webhook ID"
+//	@Param			offset		query		int							false	"Pagination offset, starting from 0 (default: 0)"
+//	@Param			page_size	query		int							false	"Number of items per page, at most 100 (default: 10)"
+//	@Success		200			{array}		models.WebhookDelivery		"Deliveries with pagination metadata"
+//	@Failure		400			{object}	models.ErrorResponse		"Invalid webhook ID, offset or page size"
+//	@Failure		404			{object}	models.ErrorResponse		"Webhook not found"
+//	@Failure		500			{object}	models.ErrorResponse		"Internal server error"
+//	@Router			/webhooks/{id}/deliveries [get]
+func GetWebhookDeliveries(c *gin.Context, logger *slog.Logger) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		logger.Warn("Invalid webhook ID", "id", c.Param("id"), "error", err)
+		models.NewErrorResponse(c, 400, err.Error())
+		return
+	}
+	offset, pageSize, fields := parsePage(c, defaultPageSize)
+	if len(fields) > 0 {
+		logger.Warn("Invalid deliveries pagination", "errors", fields)
+		models.NewProblem(c, http.StatusBadRequest, models.CodeValidationFailed, "invalid pagination", fields)
+		return
+	}
+
+	deliveries, total, err := postgres.GetDeliveries(c.Request.Context(), uint(id), offset, pageSize)
+	if err != nil {
+		abortWithError(c, logger, "Error fetching webhook deliveries", err, "id", id)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"data": deliveries,
+		"pagination": gin.H{
+			"total":     total,
+			"offset":    offset,
+			"page_size": pageSize,
+		},
+	})
+}
+
+// RedeliverWebhook godoc
+//
+//	@Summary		Redeliver a webhook event
+//	@Description	Queues the payload of an earlier delivery again. The new attempt gets its own entry in the delivery log.
+//	@Tags			webhooks
+//	@Produce		json
+//	@Param			id			path		int						true	"Webhook ID"
+//	@Param			delivery_id	path		int						true	"Delivery ID"
+//	@Success		200			{object}	models.WebhookDelivery	"Queued delivery"
+//	@Failure		400			{object}	models.ErrorResponse	"Invalid ID"
+//	@Failure		404			{object}	models.ErrorResponse	"Delivery not found"
+//	@Router			/webhooks/{id}/deliveries/{delivery_id}/redeliver [post]
+func RedeliverWebhook(c *gin.Context, logger *slog.Logger) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		logger.Warn("Invalid webhook ID", "id", c.Param("id"), "error", err)
+		models.NewErrorResponse(c, 400, err.Error())
+		return
+	}
+	deliveryID, err := strconv.Atoi(c.Param("delivery_id"))
+	if err != nil {
+		logger.Warn("Invalid delivery ID", "delivery_id", c.Param("delivery_id"), "error", err)
+		models.NewErrorResponse(c, 400, err.Error())
+		return
+	}
+	delivery, err := postgres.Redeliver(c.Request.Context(), uint(id), uint(deliveryID))
+	if err != nil {
+		abortWithError(c, logger, "Error queuing redelivery", err, "webhook_id", id, "delivery_id", deliveryID)
+		return
+	}
+	logger.Info("Queued webhook redelivery", "webhook_id", id, "delivery_id", delivery.ID)
+	c.JSON(http.StatusOK, gin.H{"delivery": delivery})
+}
+
+// validateWebhook проверяет адрес и список событий подписки.
+func validateWebhook(webhook *models.Webhook) error {
+	u, err := url.Parse(webhook.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("url must be an absolute http or https URL")
+	}
+	if len(webhook.Events) == 0 {
+		return fmt.Errorf("at least one event type is required")
+	}
+	for _, pattern := range webhook.Events {
+		known := false
+		for _, t := range events.Types {
+			if events.Matches(pattern, t) {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return fmt.Errorf("unknown event type %q", pattern)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+package webhooks
+
+import (
+	"Music_Library/config"
+	"Music_Library/internal/database/postgres"
+	"Music_Library/internal/models"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	SignatureHeader = "X-Webhook-Signature"
+	TimestampHeader = "X-Webhook-Timestamp"
+	EventHeader     = "X-Webhook-Event"
+	DeliveryHeader  = "X-Webhook-Delivery"
+)
+
+// Dispatcher доставляет подписчикам события из очереди, которую репозиторий пополняет
+// в транзакциях изменений каталога.
+type Dispatcher struct {
+	log    *slog.Logger
+	cfg    config.WebhookConfig
+	client *http.Client
+	done   chan struct{}
+}
+
+func NewDispatcher(log *slog.Logger, cfg config.WebhookConfig) *Dispatcher {
+	return &Dispatcher{
+		log:    log,
+		cfg:    cfg,
+		client: &http.Client{Timeout: cfg.Timeout},
+		done:   make(chan struct{}),
+	}
+}
+
+// Start запускает обработку очереди до отмены ctx. Начатые к этому моменту доставки
+// завершаются, дождаться их можно через Wait.
+func (d *Dispatcher) Start(ctx context.Context) {
+	go d.run(ctx)
+}
+
+// Wait блокируется, пока обработка очереди, запущенная через Start, не остановится.
+func (d *Dispatcher) Wait() {
+	<-d.done
+}
+
+func (d *Dispatcher) run(ctx context.Context) {
+	defer close(d.done)
+	ticker := time.NewTicker(d.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			d.deliverDue(ctx)
+		}
+	}
+}
+
+func (d *Dispatcher) deliverDue(ctx context.Context) {
+	deliveries, err := postgres.ClaimDueDeliveries(ctx, d.cfg.BatchSize, d.cfg.Timeout*2)
+	if err != nil {
+		d.log.Error("Failed to claim webhook deliveries", "error", err)
+		return
+	}
+	for i := range deliveries {
+		if ctx.Err() != nil {
+			return
+		}
+		// Отмена ctx не прерывает начатую доставку: её ограничивает таймаут клиента.
+		d.deliver(context.WithoutCancel(ctx), &deliveries[i])
+	}
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, delivery *models.WebhookDelivery) {
+	webhook, err := postgres.GetWebhook(ctx, delivery.WebhookID)
+	if errors.Is(err, postgres.ErrNotFound) {
+		// Подписки больше нет: без отметки доставка осталась бы в очереди и бралась бы при каждой аренде.
+		delivery.Status = models.DeliveryFailed
+		delivery.LastError = err.Error()
+		if err = postgres.SaveDeliveryAttempt(ctx, delivery); err != nil {
+			d.log.Error("Failed to save webhook delivery", "delivery_id", delivery.ID, "error", err)
+			return
+		}
+		d.log.Warn("Webhook of delivery no longer exists", "delivery_id", delivery.ID, "webhook_id", delivery.WebhookID)
+		return
+	}
+
+	// Другая ошибка чтения подписки считается неудачной попыткой и повторяется, как ошибка отправки.
+	delivery.Attempts++
+	status := 0
+	if err == nil {
+		status, err = d.send(ctx, webhook, delivery)
+	} else {
+		err = fmt.Errorf("load webhook: %w", err)
+	}
+	delivery.ResponseStatus = status
+	switch {
+	case err == nil:
+		now := time.Now()
+		delivery.Status = models.DeliveryDelivered
+		delivery.DeliveredAt = &now
+		delivery.LastError = ""
+	case delivery.Attempts >= d.cfg.MaxAttempts:
+		delivery.Status = models.DeliveryFailed
+		delivery.LastError = err.Error()
+	default:
+		delivery.NextAttemptAt = time.Now().Add(d.backoff(delivery.Attempts))
+		delivery.LastError = err.Error()
+	}
+
+	if err = postgres.SaveDeliveryAttempt(ctx, delivery); err != nil {
+		d.log.Error("Failed to save webhook delivery", "delivery_id", delivery.ID, "error", err)
+		return
+	}
+	d.log.Info("Webhook delivery attempted", "delivery_id", delivery.ID, "webhook_id", delivery.WebhookID,
+		"status", delivery.Status, "attempts", delivery.Attempts, "response_status", status)
+}
+
+func (d *Dispatcher) send(ctx context.Context, webhook *models.Webhook, delivery *models.WebhookDelivery) (int, error) {
+	body := []byte(delivery.Payload)
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, delivery.Event)
+	req.Header.Set(DeliveryHeader, strconv.FormatUint(uint64(delivery.ID), 10))
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, Sign(webhook.Secret, timestamp, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("webhook responded with %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+// backoff возвращает задержку перед следующей попыткой: BaseBackoff * 2^(attempts-1), но не больше MaxBackoff.
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	delay := d.cfg.BaseBackoff << (attempts - 1)
+	if delay <= 0 || delay > d.cfg.MaxBackoff {
+		return d.cfg.MaxBackoff
+	}
+	return delay
+}
+
+// Sign вычисляет подпись HMAC-SHA256 от строки "<timestamp>.<body>".
+// Получатель должен пересчитать её своим секретом и сравнить с заголовком X-Webhook-Signature.
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// NewSecret генерирует случайный секрет для подписи.
+func NewSecret() string {
+	b := make([]byte, 32)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -3,27 +3,167 @@ package main
 import (
 	"Music_Library/config"
 	"Music_Library/internal/database/postgres"
+	"Music_Library/internal/events"
+	"Music_Library/internal/metrics"
 	"Music_Library/internal/router"
+	"Music_Library/internal/songinfo"
+	"Music_Library/internal/storage"
+	"Music_Library/internal/tracing"
+	"Music_Library/internal/transport/grpcserver"
+	"Music_Library/internal/webhooks"
+	"context"
+	"errors"
+	"google.golang.org/grpc"
 	"log/slog"
+	"net"
+	"net/http"
 	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
 )
 
 const (
 	envLocal = "local"
 	envDev   = "dev"
+	envProd  = "prod"
 )
 
 func main() {
-	cfg := config.Load()
+	cfg := config.MustLoad()
 
 	log := setupLogger(cfg.Env)
 
-	postgres.SetupDatabase(log, cfg)
-	r := router.NewRouter(log)
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
-	if err := r.Run(":8080"); err != nil {
-		log.Error("Failed to start server")
+	shutdownTracing, err := tracing.Setup(ctx, log, cfg.Tracing)
+	if err != nil {
+		log.Error("Failed to set up tracing", "error", err)
+		os.Exit(1)
 	}
+
+	if err := postgres.SetupDatabase(log, cfg); err != nil {
+		log.Error("Failed to set up database", "error", err)
+		os.Exit(1)
+	}
+	if err := tracing.InstrumentGORM(postgres.DB); err != nil {
+		log.Error("Failed to set up database tracing", "error", err)
+		os.Exit(1)
+	}
+	if err := metrics.Setup(log); err != nil {
+		log.Error("Failed to set up metrics", "error", err)
+		os.Exit(1)
+	}
+	if err := storage.SetupStorage(log, cfg); err != nil {
+		log.Error("Failed to set up blob storage", "error", err)
+		os.Exit(1)
+	}
+	songinfo.Setup(log, cfg)
+	events.SetupFeed(cfg.Events.LogSize)
+	dispatcher := webhooks.NewDispatcher(log, cfg.Webhooks)
+	dispatcher.Start(ctx)
+	r := router.NewRouter(log, cfg)
+
+	var grpcServer *grpc.Server
+	if cfg.GRPC.Enabled {
+		lis, err := net.Listen("tcp", cfg.GRPC.Address)
+		if err != nil {
+			log.Error("Failed to listen for gRPC", "address", cfg.GRPC.Address, "error", err)
+			os.Exit(1)
+		}
+		grpcServer = grpcserver.New(log)
+		go func() {
+			log.Info("gRPC server started", "address", cfg.GRPC.Address)
+			if err := grpcServer.Serve(lis); err != nil {
+				log.Error("gRPC server stopped", "error", err)
+			}
+		}()
+	}
+
+	srv := &http.Server{
+		Addr:              cfg.Server.Address,
+		Handler:           r,
+		ReadTimeout:       cfg.Server.ReadTimeout,
+		ReadHeaderTimeout: cfg.Server.ReadHeaderTimeout,
+		WriteTimeout:      cfg.Server.WriteTimeout,
+		IdleTimeout:       cfg.Server.IdleTimeout,
+	}
+	// Потоки событий не завершаются сами, поэтому при остановке их закрываем явно.
+	srv.RegisterOnShutdown(events.Live.Close)
+
+	go func() {
+		log.Info("HTTP server started", "address", cfg.Server.Address)
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("Failed to start server", "error", err)
+			stop()
+		}
+	}()
+
+	<-ctx.Done()
+	log.Info("Shutting down", "grace_period", cfg.Server.ShutdownTimeout)
+	shutdown(log, cfg.Server.ShutdownTimeout, srv, grpcServer, dispatcher)
+
+	flushCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := shutdownTracing(flushCtx); err != nil {
+		log.Error("Failed to flush traces", "error", err)
+	}
+}
+
+// shutdown дожидается завершения начатых запросов и доставок не дольше grace,
+// после чего закрывает оставшиеся соединения и пул базы данных.
+func shutdown(log *slog.Logger, grace time.Duration, srv *http.Server, grpcServer *grpc.Server, dispatcher *webhooks.Dispatcher) {
+	ctx, cancel := context.WithTimeout(context.Background(), grace)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		if err := srv.Shutdown(ctx); err != nil {
+			log.Warn("HTTP server did not drain in time, closing connections", "error", err)
+			_ = srv.Close()
+		}
+	}()
+	if grpcServer != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			stopped := make(chan struct{})
+			go func() {
+				grpcServer.GracefulStop()
+				close(stopped)
+			}()
+			select {
+			case <-stopped:
+			case <-ctx.Done():
+				log.Warn("gRPC server did not drain in time, closing connections")
+				grpcServer.Stop()
+			}
+		}()
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		waited := make(chan struct{})
+		go func() {
+			dispatcher.Wait()
+			close(waited)
+		}()
+		select {
+		case <-waited:
+		case <-ctx.Done():
+			log.Warn("Webhook deliveries did not finish in time")
+		}
+	}()
+	wg.Wait()
+
+	if err := postgres.Close(); err != nil {
+		log.Error("Failed to close database", "error", err)
+	}
+	log.Info("Server stopped")
 }
 
 func setupLogger(env string) *slog.Logger {
@@ -33,6 +173,8 @@ func setupLogger(env string) *slog.Logger {
 		log = slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}))
 	case envDev:
 		log = slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	case envProd:
+		log = slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo}))
 	}
 	return log
 }